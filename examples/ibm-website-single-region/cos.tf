@@ -0,0 +1,54 @@
+########################################################
+# Static assets of the website in a COS bucket
+########################################################
+
+# The site directory is synced to the bucket, files that are changed or
+# removed locally are updated or deleted in the bucket on the next apply.
+
+data "ibm_resource_group" "cos_group" {
+  name = var.resource_group_name
+}
+
+resource "ibm_resource_instance" "cos_instance" {
+  name              = "website-cos"
+  resource_group_id = data.ibm_resource_group.cos_group.id
+  service           = "cloud-object-storage"
+  plan              = "standard"
+  location          = "global"
+}
+
+resource "ibm_cos_bucket" "site" {
+  bucket_name          = var.cos_bucket_name
+  resource_instance_id = ibm_resource_instance.cos_instance.id
+  region_location      = var.cos_region
+  storage_class        = "smart"
+}
+
+resource "ibm_cos_bucket_directory" "site" {
+  bucket_crn      = ibm_cos_bucket.site.crn
+  bucket_location = ibm_cos_bucket.site.region_location
+  source          = "${path.module}/site"
+}
+
+# Allow anonymous reads of the bucket, so that the assets can be served to the
+# browsers of the website users
+data "ibm_iam_access_group" "public_access" {
+  access_group_name = "Public Access"
+}
+
+resource "ibm_iam_access_group_policy" "site_public_read" {
+  access_group_id = data.ibm_iam_access_group.public_access.groups[0].id
+  roles           = ["Object Reader"]
+
+  resources {
+    service              = "cloud-object-storage"
+    resource_type        = "bucket"
+    resource_instance_id = ibm_resource_instance.cos_instance.guid
+    resource             = ibm_cos_bucket.site.bucket_name
+  }
+}
+
+data "ibm_cos_bucket_objects" "site" {
+  bucket_crn      = ibm_cos_bucket_directory.site.bucket_crn
+  bucket_location = ibm_cos_bucket_directory.site.bucket_location
+}
@@ -4,3 +4,11 @@ output "web_dns_name" {
   value = "http://${ibm_lbaas.lbaas1.vip}"
 }
 
+
+output "static_assets_url" {
+  value = "https://s3.${var.cos_region}.cloud-object-storage.appdomain.cloud/${ibm_cos_bucket.site.bucket_name}/index.html"
+}
+
+output "static_assets" {
+  value = data.ibm_cos_bucket_objects.site.keys
+}
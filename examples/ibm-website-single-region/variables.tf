@@ -78,3 +78,18 @@ variable "ssl_private_key" {
   #     EOF
 }
 
+
+variable "resource_group_name" {
+  description = "Resource group of the COS instance of the static assets"
+  default     = "default"
+}
+
+variable "cos_bucket_name" {
+  description = "Name of the COS bucket of the static assets, it has to be globally unique"
+  default     = "website-static-assets"
+}
+
+variable "cos_region" {
+  description = "Region of the COS bucket of the static assets"
+  default     = "eu-gb"
+}
@@ -75,6 +75,9 @@ var ISRouteNextHop string
 var WorkspaceID string
 var TemplateID string
 var ActionID string
+var SchematicsAgentClusterID string
+var SchematicsAgentID string
+var CmShareAccountID string
 var JobID string
 var RepoURL string
 var RepoBranch string
@@ -662,6 +665,18 @@ func init() {
 		ActionID = "us-east.ACTION.action_pm.a4ffeec3"
 		fmt.Println("[INFO] Set the environment variable SCHEMATICS_ACTION_ID for testing schematics resources else it is set to default value")
 	}
+	SchematicsAgentClusterID = os.Getenv("SCHEMATICS_AGENT_CLUSTER_ID")
+	if SchematicsAgentClusterID == "" {
+		fmt.Println("[INFO] Set the environment variable SCHEMATICS_AGENT_CLUSTER_ID for testing ibm_schematics_agent and ibm_schematics_agent_deploy resources else tests will fail if this is not set correctly")
+	}
+	SchematicsAgentID = os.Getenv("SCHEMATICS_AGENT_ID")
+	if SchematicsAgentID == "" {
+		fmt.Println("[INFO] Set the environment variable SCHEMATICS_AGENT_ID for testing ibm_schematics_policy resource else tests will fail if this is not set correctly")
+	}
+	CmShareAccountID = os.Getenv("CM_SHARE_ACCOUNT_ID")
+	if CmShareAccountID == "" {
+		fmt.Println("[INFO] Set the environment variable CM_SHARE_ACCOUNT_ID for testing ibm_cm_catalog_access resource else tests will fail if this is not set correctly")
+	}
 	JobID = os.Getenv("SCHEMATICS_JOB_ID")
 	if JobID == "" {
 		JobID = "us-east.ACTION.action_pm.a4ffeec3"
@@ -63,7 +63,9 @@ import (
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	iamaccessgroups "github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	iamidentity "github.com/IBM/platform-services-go-sdk/iamidentityv1"
@@ -71,6 +73,7 @@ import (
 	ibmcloudshellv1 "github.com/IBM/platform-services-go-sdk/ibmcloudshellv1"
 	resourcecontroller "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	resourcemanager "github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
 	"github.com/IBM/push-notifications-go-sdk/pushservicev1"
 	"github.com/IBM/scc-go-sdk/findingsv1"
 	"github.com/IBM/scc-go-sdk/v3/adminserviceapiv1"
@@ -109,6 +112,7 @@ import (
 	ibmpisession "github.com/IBM-Cloud/power-go-client/ibmpisession"
 	"github.com/IBM-Cloud/terraform-provider-ibm/version"
 	"github.com/IBM/event-notifications-go-admin-sdk/eventnotificationsv1"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
 	"github.com/IBM/eventstreams-go-sdk/pkg/schemaregistryv1"
 	"github.com/IBM/scc-go-sdk/v3/posturemanagementv1"
 )
@@ -268,6 +272,9 @@ type ClientSession interface {
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
 	EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error)
+	EnterpriseBillingUnitsV1() (*enterprisebillingunitsv1.EnterpriseBillingUnitsV1, error)
+	EnterpriseUsageReportsV1() (*enterpriseusagereportsv1.EnterpriseUsageReportsV1, error)
+	UsageReportsV4() (*usagereportsv4.UsageReportsV4, error)
 	ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error)
 	SecretsManagerV1() (*secretsmanagerv1.SecretsManagerV1, error)
 	SchematicsV1() (*schematicsv1.SchematicsV1, error)
@@ -278,11 +285,13 @@ type ClientSession interface {
 	AtrackerV1() (*atrackerv1.AtrackerV1, error)
 	AtrackerV2() (*atrackerv2.AtrackerV2, error)
 	ESschemaRegistrySession() (*schemaregistryv1.SchemaregistryV1, error)
+	ESadminRestSession() (*adminrestv1.AdminrestV1, error)
 	FindingsV1() (*findingsv1.FindingsV1, error)
 	AdminServiceApiV1() (*adminserviceapiv1.AdminServiceApiV1, error)
 	ConfigurationGovernanceV1() (*configurationgovernancev1.ConfigurationGovernanceV1, error)
 	PostureManagementV1() (*posturemanagementv1.PostureManagementV1, error)
 	ContextBasedRestrictionsV1() (*contextbasedrestrictionsv1.ContextBasedRestrictionsV1, error)
+	ActivityTrackerLoggingAPI(region, endpointType string) (*core.BaseService, error)
 	PostureManagementV2() (*posturemanagementv2.PostureManagementV2, error)
 }
 
@@ -511,6 +520,15 @@ type clientSession struct {
 	enterpriseManagementClient    *enterprisemanagementv1.EnterpriseManagementV1
 	enterpriseManagementClientErr error
 
+	enterpriseBillingUnitsClient    *enterprisebillingunitsv1.EnterpriseBillingUnitsV1
+	enterpriseBillingUnitsClientErr error
+
+	enterpriseUsageReportsClient    *enterpriseusagereportsv1.EnterpriseUsageReportsV1
+	enterpriseUsageReportsClientErr error
+
+	usageReportsClient    *usagereportsv4.UsageReportsV4
+	usageReportsClientErr error
+
 	//Resource Controller Option
 	resourceControllerErr   error
 	resourceControllerAPI   *resourcecontroller.ResourceControllerV2
@@ -559,6 +577,9 @@ type clientSession struct {
 	esSchemaRegistryClient *schemaregistryv1.SchemaregistryV1
 	esSchemaRegistryErr    error
 
+	esAdminRestClient *adminrestv1.AdminrestV1
+	esAdminRestErr    error
+
 	// Security and Compliance Center (SCC)
 	findingsClient    *findingsv1.FindingsV1
 	findingsClientErr error
@@ -582,6 +603,9 @@ type clientSession struct {
 	// context Based Restrictions (CBR)
 	contextBasedRestrictionsClient    *contextbasedrestrictionsv1.ContextBasedRestrictionsV1
 	contextBasedRestrictionsClientErr error
+
+	// endpoints file of the clients built on request
+	endpointsFile map[string]interface{}
 }
 
 // AppIDAPI provides AppID Service APIs ...
@@ -1006,6 +1030,21 @@ func (session clientSession) EnterpriseManagementV1() (*enterprisemanagementv1.E
 	return session.enterpriseManagementClient, session.enterpriseManagementClientErr
 }
 
+// Enterprise Billing Units
+func (session clientSession) EnterpriseBillingUnitsV1() (*enterprisebillingunitsv1.EnterpriseBillingUnitsV1, error) {
+	return session.enterpriseBillingUnitsClient, session.enterpriseBillingUnitsClientErr
+}
+
+// Enterprise Usage Reports
+func (session clientSession) EnterpriseUsageReportsV1() (*enterpriseusagereportsv1.EnterpriseUsageReportsV1, error) {
+	return session.enterpriseUsageReportsClient, session.enterpriseUsageReportsClientErr
+}
+
+// Usage Reports
+func (session clientSession) UsageReportsV4() (*usagereportsv4.UsageReportsV4, error) {
+	return session.usageReportsClient, session.usageReportsClientErr
+}
+
 // ResourceController Session
 func (sess clientSession) ResourceControllerV2API() (*resourcecontroller.ResourceControllerV2, error) {
 	return sess.resourceControllerAPI, sess.resourceControllerErr
@@ -1073,6 +1112,14 @@ func (session clientSession) ESschemaRegistrySession() (*schemaregistryv1.Schema
 	return session.esSchemaRegistryClient, session.esSchemaRegistryErr
 }
 
+// Event Streams admin REST API, the service URL is set per instance
+func (session clientSession) ESadminRestSession() (*adminrestv1.AdminrestV1, error) {
+	if session.esAdminRestErr != nil {
+		return session.esAdminRestClient, session.esAdminRestErr
+	}
+	return session.esAdminRestClient.Clone(), nil
+}
+
 // Security and Compliance center Findings API
 func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
 	if session.findingsClientErr != nil {
@@ -1111,6 +1158,37 @@ func (session clientSession) ContextBasedRestrictionsV1() (*contextbasedrestrict
 	return session.contextBasedRestrictionsClient, session.contextBasedRestrictionsClientErr
 }
 
+// ActivityTrackerLoggingAPI returns a client of the logging API of the Activity Tracker instances
+// in region, the endpoints file or IBMCLOUD_ATRACKER_LOGGING_API_ENDPOINT override its URL
+func (session clientSession) ActivityTrackerLoggingAPI(region, endpointType string) (*core.BaseService, error) {
+	bluemixSession, err := session.BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	loggingURL := fmt.Sprintf("https://api.%s.logging.cloud.ibm.com", region)
+	if endpointType == "private" {
+		loggingURL = fmt.Sprintf("https://api.private.%s.logging.cloud.ibm.com", region)
+	}
+	if session.endpointsFile != nil {
+		loggingURL = fileFallBack(session.endpointsFile, endpointType, "IBMCLOUD_ATRACKER_LOGGING_API_ENDPOINT", region, loggingURL)
+	}
+	client, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           EnvFallBack([]string{"IBMCLOUD_ATRACKER_LOGGING_API_ENDPOINT"}, loggingURL),
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error occurred while configuring Activity Tracker logging API: %q", err)
+	}
+	httpClient := core.DefaultHTTPClient()
+	httpClient.Timeout = bluemixSession.Config.HTTPTimeout
+	client.SetHTTPClient(httpClient)
+	client.EnableRetries(*bluemixSession.Config.MaxRetries, *bluemixSession.Config.RetryDelay)
+	client.SetDefaultHeaders(gohttp.Header{
+		"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+	})
+	return client, nil
+}
+
 // ClientSession configures and returns a fully initialized ClientSession
 func (c *Config) ClientSession() (interface{}, error) {
 	sess, err := newSession(c)
@@ -1150,6 +1228,9 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.resourceControllerConfigErr = errEmptyBluemixCredentials
 		session.resourceControllerConfigErrv2 = errEmptyBluemixCredentials
 		session.enterpriseManagementClientErr = errEmptyBluemixCredentials
+		session.enterpriseBillingUnitsClientErr = errEmptyBluemixCredentials
+		session.enterpriseUsageReportsClientErr = errEmptyBluemixCredentials
+		session.usageReportsClientErr = errEmptyBluemixCredentials
 		session.resourceControllerErr = errEmptyBluemixCredentials
 		session.catalogManagementClientErr = errEmptyBluemixCredentials
 		session.ibmpiConfigErr = errEmptyBluemixCredentials
@@ -1197,6 +1278,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.iamPolicyManagementErr = errEmptyBluemixCredentials
 		session.satelliteLinkClientErr = errEmptyBluemixCredentials
 		session.esSchemaRegistryErr = errEmptyBluemixCredentials
+		session.esAdminRestErr = errEmptyBluemixCredentials
 		session.contextBasedRestrictionsClientErr = errEmptyBluemixCredentials
 		session.postureManagementClientErr = errEmptyBluemixCredentials
 		session.postureManagementClientErrv2 = errEmptyBluemixCredentials
@@ -1284,6 +1366,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 			log.Fatalf("Unable to unmarshal Endpoints File %s", err)
 		}
 	}
+	session.endpointsFile = fileMap
 	accv1API, err := accountv1.New(sess.BluemixSession)
 	if err != nil {
 		session.accountV1ConfigErr = fmt.Errorf("[ERROR] Error occured while configuring Bluemix Accountv1 Service: %q", err)
@@ -2799,6 +2882,69 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.enterpriseManagementClient = enterpriseManagementClient
 
+	// ENTERPRISE BILLING UNITS Service
+	enterpriseBillingUnitsURL := enterprisebillingunitsv1.DefaultServiceURL
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		enterpriseBillingUnitsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_BILLING_API_ENDPOINT", c.Region, enterpriseBillingUnitsURL)
+	}
+	enterpriseBillingUnitsClientOptions := &enterprisebillingunitsv1.EnterpriseBillingUnitsV1Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_BILLING_API_ENDPOINT"}, enterpriseBillingUnitsURL),
+	}
+	enterpriseBillingUnitsClient, err := enterprisebillingunitsv1.NewEnterpriseBillingUnitsV1(enterpriseBillingUnitsClientOptions)
+	if err != nil {
+		session.enterpriseBillingUnitsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Billing Units API service: %q", err)
+	}
+	if enterpriseBillingUnitsClient != nil && enterpriseBillingUnitsClient.Service != nil {
+		enterpriseBillingUnitsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enterpriseBillingUnitsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.enterpriseBillingUnitsClient = enterpriseBillingUnitsClient
+
+	// ENTERPRISE USAGE REPORTS Service
+	enterpriseUsageReportsURL := enterpriseusagereportsv1.DefaultServiceURL
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		enterpriseUsageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_ENTERPRISE_USAGE_REPORTS_API_ENDPOINT", c.Region, enterpriseUsageReportsURL)
+	}
+	enterpriseUsageReportsClientOptions := &enterpriseusagereportsv1.EnterpriseUsageReportsV1Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_ENTERPRISE_USAGE_REPORTS_API_ENDPOINT"}, enterpriseUsageReportsURL),
+	}
+	enterpriseUsageReportsClient, err := enterpriseusagereportsv1.NewEnterpriseUsageReportsV1(enterpriseUsageReportsClientOptions)
+	if err != nil {
+		session.enterpriseUsageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Enterprise Usage Reports API service: %q", err)
+	}
+	if enterpriseUsageReportsClient != nil && enterpriseUsageReportsClient.Service != nil {
+		enterpriseUsageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		enterpriseUsageReportsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.enterpriseUsageReportsClient = enterpriseUsageReportsClient
+
+	// USAGE REPORTS Service
+	usageReportsURL := usagereportsv4.DefaultServiceURL
+	if fileMap != nil && c.Visibility != "public-and-private" {
+		usageReportsURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_USAGE_REPORTS_API_ENDPOINT", c.Region, usageReportsURL)
+	}
+	usageReportsClientOptions := &usagereportsv4.UsageReportsV4Options{
+		Authenticator: authenticator,
+		URL:           EnvFallBack([]string{"IBMCLOUD_USAGE_REPORTS_API_ENDPOINT"}, usageReportsURL),
+	}
+	usageReportsClient, err := usagereportsv4.NewUsageReportsV4(usageReportsClientOptions)
+	if err != nil {
+		session.usageReportsClientErr = fmt.Errorf("[ERROR] Error occurred while configuring IBM Cloud Usage Reports API service: %q", err)
+	}
+	if usageReportsClient != nil && usageReportsClient.Service != nil {
+		usageReportsClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		usageReportsClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+	session.usageReportsClient = usageReportsClient
+
 	// RESOURCE CONTROLLER Service
 	rcURL := resourcecontroller.DefaultServiceURL
 	if c.Visibility == "private" {
@@ -2918,6 +3064,20 @@ func (c *Config) ClientSession() (interface{}, error) {
 		})
 	}
 
+	esAdminRestV1Options := &adminrestv1.AdminrestV1Options{
+		Authenticator: authenticator,
+	}
+	session.esAdminRestClient, err = adminrestv1.NewAdminrestV1(esAdminRestV1Options)
+	if err != nil {
+		session.esAdminRestErr = fmt.Errorf("[ERROR] Error occured while configuring Event Streams admin REST: %q", err)
+	}
+	if session.esAdminRestClient != nil && session.esAdminRestClient.Service != nil {
+		session.esAdminRestClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.esAdminRestClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	}
+
 	// Governance Service
 	var configServiceApiClientURL string
 	if c.Visibility == "private" || c.Visibility == "public-and-private" {
@@ -0,0 +1,538 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// IAM Policy Management v2
+//
+// The iampolicymanagementv1 SDK only implements the v1 policy model. The v2
+// model adds rule conditions, such as time-based conditions, and patterns.
+// The v2 policies are sent through the v1 client, which shares the endpoint
+// and the authenticator of the v2 API.
+
+type V2Policy struct {
+	ID               *string                   `json:"id,omitempty"`
+	Type             *string                   `json:"type,omitempty"`
+	Description      *string                   `json:"description,omitempty"`
+	Subject          *V2PolicySubject          `json:"subject,omitempty"`
+	Resource         *V2PolicyResource         `json:"resource,omitempty"`
+	Pattern          *string                   `json:"pattern,omitempty"`
+	Rule             *V2PolicyRule             `json:"rule,omitempty"`
+	Control          *V2PolicyControl          `json:"control,omitempty"`
+	Template         *V2PolicyTemplateMetadata `json:"template,omitempty"`
+	State            *string                   `json:"state,omitempty"`
+	Href             *string                   `json:"href,omitempty"`
+	CreatedAt        *string                   `json:"created_at,omitempty"`
+	CreatedByID      *string                   `json:"created_by_id,omitempty"`
+	LastModifiedAt   *string                   `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string                   `json:"last_modified_by_id,omitempty"`
+}
+
+type V2PolicyCollection struct {
+	Policies []V2Policy `json:"policies"`
+	Next     *struct {
+		Start *string `json:"start,omitempty"`
+	} `json:"next,omitempty"`
+}
+
+type V2PolicySubject struct {
+	Attributes []V2PolicyAttribute `json:"attributes"`
+}
+
+type V2PolicyResource struct {
+	Attributes []V2PolicyAttribute `json:"attributes"`
+	Tags       []V2PolicyAttribute `json:"tags,omitempty"`
+}
+
+// V2PolicyAttribute is a subject or resource attribute, or a resource tag.
+// Value is a string, or a bool for the stringExists operator.
+type V2PolicyAttribute struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+// V2PolicyRule is either a single condition, or a list of conditions combined with Operator.
+type V2PolicyRule struct {
+	Key        *string                 `json:"key,omitempty"`
+	Operator   *string                 `json:"operator,omitempty"`
+	Value      interface{}             `json:"value,omitempty"`
+	Conditions []V2PolicyRuleCondition `json:"conditions,omitempty"`
+}
+
+// V2PolicyRuleCondition is a condition of a rule. Value is a string or a list of strings.
+type V2PolicyRuleCondition struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+type V2PolicyControl struct {
+	Grant V2PolicyGrant `json:"grant"`
+}
+
+type V2PolicyGrant struct {
+	Roles []V2PolicyRole `json:"roles"`
+}
+
+type V2PolicyRole struct {
+	RoleID      *string `json:"role_id"`
+	DisplayName *string `json:"display_name,omitempty"`
+}
+
+// V2PolicyTemplateMetadata identifies the policy template a policy was assigned from
+type V2PolicyTemplateMetadata struct {
+	ID           *string `json:"id,omitempty"`
+	Version      *string `json:"version,omitempty"`
+	AssignmentID *string `json:"assignment_id,omitempty"`
+	RootID       *string `json:"root_id,omitempty"`
+	RootVersion  *string `json:"root_version,omitempty"`
+}
+
+// IAMPolicyRuleConditionsSchema is the rule_conditions argument of the policy resources
+func IAMPolicyRuleConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Rule conditions enforced by the policy",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Key of the condition",
+				},
+				"operator": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Operator of the condition",
+				},
+				"value": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Value of the condition",
+				},
+			},
+		},
+	}
+}
+
+// IAMPolicyRuleOperatorSchema is the rule_operator argument of the policy resources
+func IAMPolicyRuleOperatorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringInSlice([]string{"and", "or"}, false),
+		Description:  "Operator that combines the rule conditions",
+	}
+}
+
+// IAMPolicyPatternSchema is the pattern argument of the policy resources
+func IAMPolicyPatternSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Pattern rule follows for time-based condition",
+	}
+}
+
+// UseV2Policy returns true when the policy of d has to be managed with the v2
+// API, that is when it has rule conditions or a pattern, or they are being removed.
+func UseV2Policy(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("rule_conditions"); ok && len(v.([]interface{})) > 0 {
+		return true
+	}
+	if _, ok := d.GetOk("pattern"); ok {
+		return true
+	}
+	return d.HasChange("rule_conditions") || d.HasChange("pattern")
+}
+
+// GenerateV2Policy converts the v1 subject, resource and roles of a policy to
+// the v2 model and adds the rule and the pattern of d.
+func GenerateV2Policy(d *schema.ResourceData, policyType string, subject iampolicymanagementv1.PolicySubject, resource iampolicymanagementv1.PolicyResource, roles []iampolicymanagementv1.PolicyRole) V2Policy {
+	policy := V2Policy{
+		Type:     core.StringPtr(policyType),
+		Subject:  &V2PolicySubject{Attributes: []V2PolicyAttribute{}},
+		Resource: &V2PolicyResource{Attributes: []V2PolicyAttribute{}},
+		Control:  &V2PolicyControl{Grant: V2PolicyGrant{Roles: []V2PolicyRole{}}},
+	}
+	for _, a := range subject.Attributes {
+		policy.Subject.Attributes = append(policy.Subject.Attributes, V2PolicyAttribute{
+			Key:      a.Name,
+			Operator: core.StringPtr("stringEquals"),
+			Value:    *a.Value,
+		})
+	}
+	for _, a := range resource.Attributes {
+		operator := "stringEquals"
+		if a.Operator != nil && *a.Operator != "" {
+			operator = *a.Operator
+		}
+		policy.Resource.Attributes = append(policy.Resource.Attributes, V2PolicyAttribute{
+			Key:      a.Name,
+			Operator: core.StringPtr(operator),
+			Value:    expandV2PolicyAttributeValue(operator, *a.Value),
+		})
+	}
+	for _, t := range resource.Tags {
+		operator := "stringEquals"
+		if t.Operator != nil && *t.Operator != "" {
+			operator = *t.Operator
+		}
+		policy.Resource.Tags = append(policy.Resource.Tags, V2PolicyAttribute{
+			Key:      t.Name,
+			Operator: core.StringPtr(operator),
+			Value:    *t.Value,
+		})
+	}
+	for _, r := range roles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, V2PolicyRole{RoleID: r.RoleID})
+	}
+	if description, ok := d.GetOk("description"); ok {
+		policy.Description = core.StringPtr(description.(string))
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		policy.Pattern = core.StringPtr(pattern.(string))
+	}
+	policy.Rule = ExpandV2PolicyRule(d)
+	return policy
+}
+
+func expandV2PolicyAttributeValue(operator, value string) interface{} {
+	if operator == "stringExists" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// ExpandV2PolicyRule builds the rule of a policy from rule_conditions and rule_operator
+func ExpandV2PolicyRule(d *schema.ResourceData) *V2PolicyRule {
+	return ExpandV2PolicyRuleConditions(d.Get("rule_conditions").([]interface{}), d.Get("rule_operator").(string))
+}
+
+// ExpandV2PolicyRuleConditions builds a rule from a list of rule_conditions combined with ruleOperator
+func ExpandV2PolicyRuleConditions(ruleConditions []interface{}, ruleOperator string) *V2PolicyRule {
+	conditions := []V2PolicyRuleCondition{}
+	for _, c := range ruleConditions {
+		condition := c.(map[string]interface{})
+		operator := condition["operator"].(string)
+		values := ExpandStringList(condition["value"].([]interface{}))
+		var value interface{} = values
+		// Only the list operators, such as dayOfWeekAnyOf or stringMatchAnyOf, take a list
+		if !strings.HasSuffix(operator, "AnyOf") && !strings.HasSuffix(operator, "AllOf") && len(values) == 1 {
+			value = values[0]
+		}
+		conditions = append(conditions, V2PolicyRuleCondition{
+			Key:      core.StringPtr(condition["key"].(string)),
+			Operator: core.StringPtr(operator),
+			Value:    value,
+		})
+	}
+	if len(conditions) == 0 {
+		return nil
+	}
+	if len(conditions) == 1 && ruleOperator == "" {
+		return &V2PolicyRule{
+			Key:      conditions[0].Key,
+			Operator: conditions[0].Operator,
+			Value:    conditions[0].Value,
+		}
+	}
+	if ruleOperator == "" {
+		ruleOperator = "and"
+	}
+	return &V2PolicyRule{
+		Operator:   core.StringPtr(ruleOperator),
+		Conditions: conditions,
+	}
+}
+
+// FlattenV2PolicyRuleConditions returns the rule_conditions of rule
+func FlattenV2PolicyRuleConditions(rule *V2PolicyRule) []map[string]interface{} {
+	conditions := []map[string]interface{}{}
+	if rule == nil {
+		return conditions
+	}
+	if len(rule.Conditions) == 0 && rule.Key != nil {
+		return append(conditions, map[string]interface{}{
+			"key":      *rule.Key,
+			"operator": *rule.Operator,
+			"value":    flattenV2PolicyRuleValue(rule.Value),
+		})
+	}
+	for _, c := range rule.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"key":      *c.Key,
+			"operator": *c.Operator,
+			"value":    flattenV2PolicyRuleValue(c.Value),
+		})
+	}
+	return conditions
+}
+
+func flattenV2PolicyRuleValue(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			values = append(values, fmt.Sprintf("%v", e))
+		}
+		return values
+	case []string:
+		return v
+	case nil:
+		return []string{}
+	default:
+		return []string{fmt.Sprintf("%v", v)}
+	}
+}
+
+// SetV2PolicyRule sets rule_conditions, rule_operator and pattern of d from policy
+func SetV2PolicyRule(d *schema.ResourceData, policy *V2Policy) {
+	if policy == nil {
+		return
+	}
+	d.Set("rule_conditions", FlattenV2PolicyRuleConditions(policy.Rule))
+	if policy.Rule != nil && len(policy.Rule.Conditions) > 0 && policy.Rule.Operator != nil {
+		d.Set("rule_operator", *policy.Rule.Operator)
+	} else {
+		d.Set("rule_operator", nil)
+	}
+	if policy.Pattern != nil {
+		d.Set("pattern", *policy.Pattern)
+	} else {
+		d.Set("pattern", nil)
+	}
+}
+
+// ConvertV2PolicyToV1 returns policy in the v1 model, so that the v1 flatten
+// functions can be used on the policies read with the v2 API.
+func ConvertV2PolicyToV1(policy *V2Policy) *iampolicymanagementv1.Policy {
+	v1Policy := &iampolicymanagementv1.Policy{
+		ID:          policy.ID,
+		Type:        policy.Type,
+		Description: policy.Description,
+		State:       policy.State,
+		Href:        policy.Href,
+	}
+	if policy.Subject != nil {
+		subject := iampolicymanagementv1.PolicySubject{}
+		for _, a := range policy.Subject.Attributes {
+			subject.Attributes = append(subject.Attributes, iampolicymanagementv1.SubjectAttribute{
+				Name:  a.Key,
+				Value: core.StringPtr(fmt.Sprintf("%v", a.Value)),
+			})
+		}
+		v1Policy.Subjects = []iampolicymanagementv1.PolicySubject{subject}
+	}
+	if policy.Resource != nil {
+		resource := iampolicymanagementv1.PolicyResource{}
+		for _, a := range policy.Resource.Attributes {
+			resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{
+				Name:     a.Key,
+				Operator: a.Operator,
+				Value:    core.StringPtr(fmt.Sprintf("%v", a.Value)),
+			})
+		}
+		for _, t := range policy.Resource.Tags {
+			resource.Tags = append(resource.Tags, iampolicymanagementv1.ResourceTag{
+				Name:     t.Key,
+				Operator: t.Operator,
+				Value:    core.StringPtr(fmt.Sprintf("%v", t.Value)),
+			})
+		}
+		v1Policy.Resources = []iampolicymanagementv1.PolicyResource{resource}
+	}
+	if policy.Control != nil {
+		for _, r := range policy.Control.Grant.Roles {
+			displayName := r.DisplayName
+			if displayName == nil || *displayName == "" {
+				displayName = core.StringPtr(roleNameFromRoleID(*r.RoleID))
+			}
+			v1Policy.Roles = append(v1Policy.Roles, iampolicymanagementv1.PolicyRole{
+				RoleID:      r.RoleID,
+				DisplayName: displayName,
+			})
+		}
+	}
+	return v1Policy
+}
+
+// roleNameFromRoleID returns the name of a role from its CRN, such as
+// Viewer for crn:v1:bluemix:public:iam::::role:Viewer
+func roleNameFromRoleID(roleID string) string {
+	parts := strings.Split(roleID, ":")
+	return parts[len(parts)-1]
+}
+
+// CreateV2Policy creates policy with the v2 API
+func CreateV2Policy(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, policy V2Policy) (*V2Policy, *core.DetailedResponse, error) {
+	result := &V2Policy{}
+	response, err := v2PolicyRequest(context, client, core.POST, "", nil, "", policy, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetV2Policy retrieves the policy policyID with the v2 API, the ETag of the response is required to replace it.
+func GetV2Policy(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, policyID string) (*V2Policy, *core.DetailedResponse, error) {
+	result := &V2Policy{}
+	response, err := v2PolicyRequest(context, client, core.GET, policyID, nil, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// ReplaceV2Policy replaces the policy policyID with the v2 API
+func ReplaceV2Policy(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, policyID, etag string, policy V2Policy) (*V2Policy, *core.DetailedResponse, error) {
+	result := &V2Policy{}
+	response, err := v2PolicyRequest(context, client, core.PUT, policyID, nil, etag, policy, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// ListV2Policies lists the policies that match query, such as the account_id and
+// access_group_id query parameters, with the v2 API
+func ListV2Policies(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, query map[string]string) ([]V2Policy, *core.DetailedResponse, error) {
+	policies := []V2Policy{}
+	for {
+		result := &V2PolicyCollection{}
+		response, err := v2PolicyRequest(context, client, core.GET, "", query, "", nil, result)
+		if err != nil {
+			return nil, response, err
+		}
+		policies = append(policies, result.Policies...)
+		if result.Next == nil || result.Next.Start == nil || *result.Next.Start == "" {
+			return policies, response, nil
+		}
+		query["start"] = *result.Next.Start
+	}
+}
+
+func v2PolicyRequest(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, method, policyID string, query map[string]string, etag string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	var err error
+	if policyID == "" {
+		_, err = builder.ResolveRequestURL(client.Service.Options.URL, `/v2/policies`, nil)
+	} else {
+		_, err = builder.ResolveRequestURL(client.Service.Options.URL, `/v2/policies/{policy_id}`, map[string]string{
+			"policy_id": policyID,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if etag != "" {
+		builder.AddHeader("If-Match", etag)
+	}
+	for k, v := range query {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// CreateIAMPolicy creates the policy described by options, with the v2 API when
+// the policy of d has rule conditions or a pattern.
+func CreateIAMPolicy(client *iampolicymanagementv1.IamPolicyManagementV1, d *schema.ResourceData, options *iampolicymanagementv1.CreatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !UseV2Policy(d) {
+		return client.CreatePolicy(options)
+	}
+	policy := GenerateV2Policy(d, *options.Type, options.Subjects[0], options.Resources[0], options.Roles)
+	created, response, err := CreateV2Policy(context.Background(), client, policy)
+	if err != nil || created == nil {
+		return nil, response, err
+	}
+	return ConvertV2PolicyToV1(created), response, nil
+}
+
+// GetIAMPolicy retrieves the policy of options, with the v2 API when the policy
+// of d has rule conditions or a pattern. Otherwise the policy is read with the
+// v1 API, and its v2 model is also checked so that the conditions added out
+// of band are detected. The v2 policy is nil for v1 policies.
+func GetIAMPolicy(client *iampolicymanagementv1.IamPolicyManagementV1, d *schema.ResourceData, options *iampolicymanagementv1.GetPolicyOptions) (*iampolicymanagementv1.Policy, *V2Policy, *core.DetailedResponse, error) {
+	if !UseV2Policy(d) {
+		policy, response, err := client.GetPolicy(options)
+		if err != nil || policy == nil {
+			return policy, nil, response, err
+		}
+		v2Policy, v2Response, err := GetV2Policy(context.Background(), client, *options.PolicyID)
+		if err != nil {
+			log.Printf("[WARN] Error retrieving the v2 model of policy (%s), its rule conditions are not checked: %s\n%s", *options.PolicyID, err, v2Response)
+			return policy, nil, response, nil
+		}
+		if hasV2PolicyConditions(v2Policy) {
+			return policy, v2Policy, response, nil
+		}
+		return policy, nil, response, nil
+	}
+	policy, response, err := GetV2Policy(context.Background(), client, *options.PolicyID)
+	if err != nil || policy == nil {
+		return nil, nil, response, err
+	}
+	return ConvertV2PolicyToV1(policy), policy, response, nil
+}
+
+// hasV2PolicyConditions tells if policy has rule conditions or a pattern,
+// which can't be carried by the v1 model
+func hasV2PolicyConditions(policy *V2Policy) bool {
+	if policy == nil {
+		return false
+	}
+	if policy.Rule != nil && (len(policy.Rule.Conditions) > 0 || policy.Rule.Key != nil) {
+		return true
+	}
+	return policy.Pattern != nil && *policy.Pattern != ""
+}
+
+// UpdateIAMPolicy updates the policy of options, with the v2 API when the policy
+// of d has or had rule conditions or a pattern. Policies created with the v1 API
+// are migrated to the v2 model when conditions are added to them.
+func UpdateIAMPolicy(client *iampolicymanagementv1.IamPolicyManagementV1, d *schema.ResourceData, options *iampolicymanagementv1.UpdatePolicyOptions) (*core.DetailedResponse, error) {
+	if !UseV2Policy(d) {
+		_, response, err := client.UpdatePolicy(options)
+		return response, err
+	}
+	// The ETag of the v1 API can't be used to replace a v2 policy
+	_, response, err := GetV2Policy(context.Background(), client, *options.PolicyID)
+	if err != nil {
+		return response, err
+	}
+	policy := GenerateV2Policy(d, *options.Type, options.Subjects[0], options.Resources[0], options.Roles)
+	_, response, err = ReplaceV2Policy(context.Background(), client, *options.PolicyID, response.Headers.Get("ETag"), policy)
+	return response, err
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/schematics"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/transitgateway"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/usagereports"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 )
@@ -282,6 +283,7 @@ func Provider() *schema.Provider {
 			"ibm_cis_firewall_rules":                cis.DataSourceIBMCISFirewallRules(),
 			"ibm_cloudant":                          cloudant.DataSourceIBMCloudant(),
 			"ibm_database":                          database.DataSourceIBMDatabaseInstance(),
+			"ibm_database_backups":                  database.DataSourceIBMDatabaseBackups(),
 			"ibm_database_connection":               database.DataSourceIBMDatabaseConnection(),
 			"ibm_database_point_in_time_recovery":   database.DataSourceIBMDatabasePointInTimeRecovery(),
 			"ibm_database_remotes":                  database.DataSourceIBMDatabaseRemotes(),
@@ -312,11 +314,13 @@ func Provider() *schema.Provider {
 			"ibm_cloud_shell_account_settings":      cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                        cos.DataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                 cos.DataSourceIBMCosBucketObject(),
+			"ibm_cos_bucket_objects":                cos.DataSourceIBMCosBucketObjects(),
 			"ibm_dns_domain_registration":           classicinfrastructure.DataSourceIBMDNSDomainRegistration(),
 			"ibm_dns_domain":                        classicinfrastructure.DataSourceIBMDNSDomain(),
 			"ibm_dns_secondary":                     classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":               eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":              eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_groups":     eventstreams.DataSourceIBMEventStreamsConsumerGroups(),
 			"ibm_hpcs":                              hpcs.DataSourceIBMHPCS(),
 			"ibm_iam_access_group":                  iamaccessgroup.DataSourceIBMIAMAccessGroup(),
 			"ibm_iam_access_group_policy":           iampolicy.DataSourceIBMIAMAccessGroupPolicy(),
@@ -338,6 +342,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_links":         iamidentity.DataSourceIBMIamTrustedProfileLinks(),
 			"ibm_iam_trusted_profiles":              iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":        iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_policy_assignments":            iampolicy.DataSourceIBMIAMPolicyAssignments(),
 
 			// bare_metal_server
 			"ibm_is_bare_metal_server_disk":                           vpc.DataSourceIBMIsBareMetalServerDisk(),
@@ -467,21 +472,24 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":               kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                       kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                        kms.DataSourceIBMKMSkey(),
+			"ibm_kms_key_registrations":          kms.DataSourceIBMKMSKeyRegistrations(),
 			"ibm_pn_application_chrome":          pushnotification.DataSourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":         appconfiguration.DataSourceIBMAppConfigEnvironment(),
 			"ibm_app_config_environments":        appconfiguration.DataSourceIBMAppConfigEnvironments(),
 			"ibm_app_config_feature":             appconfiguration.DataSourceIBMAppConfigFeature(),
 			"ibm_app_config_features":            appconfiguration.DataSourceIBMAppConfigFeatures(),
 
-			"ibm_resource_quota":    resourcecontroller.DataSourceIBMResourceQuota(),
-			"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
-			"ibm_resource_instance": resourcecontroller.DataSourceIBMResourceInstance(),
-			"ibm_resource_key":      resourcecontroller.DataSourceIBMResourceKey(),
-			"ibm_security_group":    classicinfrastructure.DataSourceIBMSecurityGroup(),
-			"ibm_service_instance":  cloudfoundry.DataSourceIBMServiceInstance(),
-			"ibm_service_key":       cloudfoundry.DataSourceIBMServiceKey(),
-			"ibm_service_plan":      cloudfoundry.DataSourceIBMServicePlan(),
-			"ibm_space":             cloudfoundry.DataSourceIBMSpace(),
+			"ibm_resource_quota":           resourcecontroller.DataSourceIBMResourceQuota(),
+			"ibm_resource_group":           resourcemanager.DataSourceIBMResourceGroup(),
+			"ibm_resource_instance":        resourcecontroller.DataSourceIBMResourceInstance(),
+			"ibm_resource_key":             resourcecontroller.DataSourceIBMResourceKey(),
+			"ibm_resource_catalog_service": resourcecontroller.DataSourceIBMResourceCatalogService(),
+			"ibm_catalog_pricing":          resourcecontroller.DataSourceIBMCatalogPricing(),
+			"ibm_security_group":           classicinfrastructure.DataSourceIBMSecurityGroup(),
+			"ibm_service_instance":         cloudfoundry.DataSourceIBMServiceInstance(),
+			"ibm_service_key":              cloudfoundry.DataSourceIBMServiceKey(),
+			"ibm_service_plan":             cloudfoundry.DataSourceIBMServicePlan(),
+			"ibm_space":                    cloudfoundry.DataSourceIBMSpace(),
 
 			// Added for Schematics
 			"ibm_schematics_workspace":      schematics.DataSourceIBMSchematicsWorkspace(),
@@ -563,6 +571,14 @@ func Provider() *schema.Provider {
 			"ibm_enterprises":               enterprise.DataSourceIBMEnterprises(),
 			"ibm_enterprise_account_groups": enterprise.DataSourceIBMEnterpriseAccountGroups(),
 			"ibm_enterprise_accounts":       enterprise.DataSourceIBMEnterpriseAccounts(),
+			"ibm_enterprise_billing_units":  enterprise.DataSourceIBMEnterpriseBillingUnits(),
+			"ibm_enterprise_credit_pools":   enterprise.DataSourceIBMEnterpriseCreditPools(),
+			"ibm_enterprise_usage_report":   enterprise.DataSourceIBMEnterpriseUsageReport(),
+
+			// Usage Reports
+			"ibm_account_usage":           usagereports.DataSourceIBMAccountUsage(),
+			"ibm_resource_group_usage":    usagereports.DataSourceIBMResourceGroupUsage(),
+			"ibm_resource_instance_usage": usagereports.DataSourceIBMResourceInstanceUsage(),
 
 			// //Added for Secrets Manager
 			"ibm_secrets_manager_secrets": secretsmanager.DataSourceIBMSecretsManagerSecrets(),
@@ -614,8 +630,9 @@ func Provider() *schema.Provider {
 			"ibm_scc_posture_scope_correlation": scc.DataSourceIBMSccPostureScopeCorrelation(),
 
 			// // Added for Context Based Restrictions
-			"ibm_cbr_zone": contextbasedrestrictions.DataSourceIBMCbrZone(),
-			"ibm_cbr_rule": contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone":           contextbasedrestrictions.DataSourceIBMCbrZone(),
+			"ibm_cbr_rule":           contextbasedrestrictions.DataSourceIBMCbrRule(),
+			"ibm_cbr_zone_addresses": contextbasedrestrictions.DataSourceIBMCbrZoneAddresses(),
 
 			// // Added for Event Notifications
 			"ibm_en_destination":          eventnotification.DataSourceIBMEnDestination(),
@@ -671,107 +688,130 @@ func Provider() *schema.Provider {
 			"ibm_appid_theme_text":               appid.ResourceIBMAppIDThemeText(),
 			"ibm_appid_user_roles":               appid.ResourceIBMAppIDUserRoles(),
 
-			"ibm_function_action":                       functions.ResourceIBMFunctionAction(),
-			"ibm_function_package":                      functions.ResourceIBMFunctionPackage(),
-			"ibm_function_rule":                         functions.ResourceIBMFunctionRule(),
-			"ibm_function_trigger":                      functions.ResourceIBMFunctionTrigger(),
-			"ibm_function_namespace":                    functions.ResourceIBMFunctionNamespace(),
-			"ibm_cis":                                   cis.ResourceIBMCISInstance(),
-			"ibm_database":                              database.ResourceIBMDatabaseInstance(),
-			"ibm_certificate_manager_import":            certificatemanager.ResourceIBMCertificateManagerImport(),
-			"ibm_certificate_manager_order":             certificatemanager.ResourceIBMCertificateManagerOrder(),
-			"ibm_cis_domain":                            cis.ResourceIBMCISDomain(),
-			"ibm_cis_domain_settings":                   cis.ResourceIBMCISSettings(),
-			"ibm_cis_firewall":                          cis.ResourceIBMCISFirewallRecord(),
-			"ibm_cis_range_app":                         cis.ResourceIBMCISRangeApp(),
-			"ibm_cis_healthcheck":                       cis.ResourceIBMCISHealthCheck(),
-			"ibm_cis_origin_pool":                       cis.ResourceIBMCISPool(),
-			"ibm_cis_global_load_balancer":              cis.ResourceIBMCISGlb(),
-			"ibm_cis_certificate_upload":                cis.ResourceIBMCISCertificateUpload(),
-			"ibm_cis_dns_record":                        cis.ResourceIBMCISDnsRecord(),
-			"ibm_cis_dns_records_import":                cis.ResourceIBMCISDNSRecordsImport(),
-			"ibm_cis_rate_limit":                        cis.ResourceIBMCISRateLimit(),
-			"ibm_cis_page_rule":                         cis.ResourceIBMCISPageRule(),
-			"ibm_cis_edge_functions_action":             cis.ResourceIBMCISEdgeFunctionsAction(),
-			"ibm_cis_edge_functions_trigger":            cis.ResourceIBMCISEdgeFunctionsTrigger(),
-			"ibm_cis_tls_settings":                      cis.ResourceIBMCISTLSSettings(),
-			"ibm_cis_waf_package":                       cis.ResourceIBMCISWAFPackage(),
-			"ibm_cis_webhook":                           cis.ResourceIBMCISWebhooks(),
-			"ibm_cis_logpush_job":                       cis.ResourceIBMCISLogPushJob(),
-			"ibm_cis_alert":                             cis.ResourceIBMCISAlert(),
-			"ibm_cis_routing":                           cis.ResourceIBMCISRouting(),
-			"ibm_cis_waf_group":                         cis.ResourceIBMCISWAFGroup(),
-			"ibm_cis_cache_settings":                    cis.ResourceIBMCISCacheSettings(),
-			"ibm_cis_custom_page":                       cis.ResourceIBMCISCustomPage(),
-			"ibm_cis_waf_rule":                          cis.ResourceIBMCISWAFRule(),
-			"ibm_cis_certificate_order":                 cis.ResourceIBMCISCertificateOrder(),
-			"ibm_cis_filter":                            cis.ResourceIBMCISFilter(),
-			"ibm_cis_firewall_rule":                     cis.ResourceIBMCISFirewallrules(),
-			"ibm_cloudant":                              cloudant.ResourceIBMCloudant(),
-			"ibm_cloud_shell_account_settings":          cloudshell.ResourceIBMCloudShellAccountSettings(),
-			"ibm_compute_autoscale_group":               classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
-			"ibm_compute_autoscale_policy":              classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
-			"ibm_compute_bare_metal":                    classicinfrastructure.ResourceIBMComputeBareMetal(),
-			"ibm_compute_dedicated_host":                classicinfrastructure.ResourceIBMComputeDedicatedHost(),
-			"ibm_compute_monitor":                       classicinfrastructure.ResourceIBMComputeMonitor(),
-			"ibm_compute_placement_group":               classicinfrastructure.ResourceIBMComputePlacementGroup(),
-			"ibm_compute_reserved_capacity":             classicinfrastructure.ResourceIBMComputeReservedCapacity(),
-			"ibm_compute_provisioning_hook":             classicinfrastructure.ResourceIBMComputeProvisioningHook(),
-			"ibm_compute_ssh_key":                       classicinfrastructure.ResourceIBMComputeSSHKey(),
-			"ibm_compute_ssl_certificate":               classicinfrastructure.ResourceIBMComputeSSLCertificate(),
-			"ibm_compute_user":                          classicinfrastructure.ResourceIBMComputeUser(),
-			"ibm_compute_vm_instance":                   classicinfrastructure.ResourceIBMComputeVmInstance(),
-			"ibm_container_addons":                      kubernetes.ResourceIBMContainerAddOns(),
-			"ibm_container_alb":                         kubernetes.ResourceIBMContainerALB(),
-			"ibm_container_alb_create":                  kubernetes.ResourceIBMContainerAlbCreate(),
-			"ibm_container_api_key_reset":               kubernetes.ResourceIBMContainerAPIKeyReset(),
-			"ibm_container_vpc_alb":                     kubernetes.ResourceIBMContainerVpcALB(),
-			"ibm_container_vpc_alb_create":              kubernetes.ResourceIBMContainerVpcAlbCreateNew(),
-			"ibm_container_vpc_worker_pool":             kubernetes.ResourceIBMContainerVpcWorkerPool(),
-			"ibm_container_vpc_cluster":                 kubernetes.ResourceIBMContainerVpcCluster(),
-			"ibm_container_alb_cert":                    kubernetes.ResourceIBMContainerALBCert(),
-			"ibm_container_cluster":                     kubernetes.ResourceIBMContainerCluster(),
-			"ibm_container_cluster_feature":             kubernetes.ResourceIBMContainerClusterFeature(),
-			"ibm_container_bind_service":                kubernetes.ResourceIBMContainerBindService(),
-			"ibm_container_worker_pool":                 kubernetes.ResourceIBMContainerWorkerPool(),
-			"ibm_container_worker_pool_zone_attachment": kubernetes.ResourceIBMContainerWorkerPoolZoneAttachment(),
-			"ibm_container_storage_attachment":          kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachment(),
-			"ibm_container_nlb_dns":                     kubernetes.ResourceIBMContainerNlbDns(),
-			"ibm_cr_namespace":                          registry.ResourceIBMCrNamespace(),
-			"ibm_cr_retention_policy":                   registry.ResourceIBMCrRetentionPolicy(),
-			"ibm_ob_logging":                            kubernetes.ResourceIBMObLogging(),
-			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
-			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
-			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
-			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
-			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
-			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
-			"ibm_dns_record":                            classicinfrastructure.ResourceIBMDNSRecord(),
-			"ibm_event_streams_topic":                   eventstreams.ResourceIBMEventStreamsTopic(),
-			"ibm_event_streams_schema":                  eventstreams.ResourceIBMEventStreamsSchema(),
-			"ibm_firewall":                              classicinfrastructure.ResourceIBMFirewall(),
-			"ibm_firewall_policy":                       classicinfrastructure.ResourceIBMFirewallPolicy(),
-			"ibm_hpcs":                                  hpcs.ResourceIBMHPCS(),
-			"ibm_iam_access_group":                      iamaccessgroup.ResourceIBMIAMAccessGroup(),
-			"ibm_iam_account_settings":                  iamidentity.ResourceIBMIAMAccountSettings(),
-			"ibm_iam_custom_role":                       iampolicy.ResourceIBMIAMCustomRole(),
-			"ibm_iam_access_group_dynamic_rule":         iamaccessgroup.ResourceIBMIAMDynamicRule(),
-			"ibm_iam_access_group_members":              iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
-			"ibm_iam_access_group_policy":               iampolicy.ResourceIBMIAMAccessGroupPolicy(),
-			"ibm_iam_authorization_policy":              iampolicy.ResourceIBMIAMAuthorizationPolicy(),
-			"ibm_iam_authorization_policy_detach":       iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
-			"ibm_iam_user_policy":                       iampolicy.ResourceIBMIAMUserPolicy(),
-			"ibm_iam_user_settings":                     iamidentity.ResourceIBMIAMUserSettings(),
-			"ibm_iam_service_id":                        iamidentity.ResourceIBMIAMServiceID(),
-			"ibm_iam_service_api_key":                   iamidentity.ResourceIBMIAMServiceAPIKey(),
-			"ibm_iam_service_policy":                    iampolicy.ResourceIBMIAMServicePolicy(),
-			"ibm_iam_user_invite":                       iampolicy.ResourceIBMIAMUserInvite(),
-			"ibm_iam_api_key":                           iamidentity.ResourceIBMIAMApiKey(),
-			"ibm_iam_trusted_profile":                   iamidentity.ResourceIBMIAMTrustedProfile(),
-			"ibm_iam_trusted_profile_claim_rule":        iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
-			"ibm_iam_trusted_profile_link":              iamidentity.ResourceIBMIAMTrustedProfileLink(),
-			"ibm_iam_trusted_profile_policy":            iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
-			"ibm_ipsec_vpn":                             classicinfrastructure.ResourceIBMIPSecVPN(),
+			"ibm_function_action":                          functions.ResourceIBMFunctionAction(),
+			"ibm_function_package":                         functions.ResourceIBMFunctionPackage(),
+			"ibm_function_rule":                            functions.ResourceIBMFunctionRule(),
+			"ibm_function_trigger":                         functions.ResourceIBMFunctionTrigger(),
+			"ibm_function_namespace":                       functions.ResourceIBMFunctionNamespace(),
+			"ibm_cis":                                      cis.ResourceIBMCISInstance(),
+			"ibm_database":                                 database.ResourceIBMDatabaseInstance(),
+			"ibm_database_allowlist_entry":                 database.ResourceIBMDatabaseAllowlistEntry(),
+			"ibm_database_backup":                          database.ResourceIBMDatabaseBackup(),
+			"ibm_database_configuration":                   database.ResourceIBMDatabaseConfiguration(),
+			"ibm_database_scaling_group":                   database.ResourceIBMDatabaseScalingGroup(),
+			"ibm_database_user":                            database.ResourceIBMDatabaseUser(),
+			"ibm_certificate_manager_import":               certificatemanager.ResourceIBMCertificateManagerImport(),
+			"ibm_certificate_manager_order":                certificatemanager.ResourceIBMCertificateManagerOrder(),
+			"ibm_cis_domain":                               cis.ResourceIBMCISDomain(),
+			"ibm_cis_domain_settings":                      cis.ResourceIBMCISSettings(),
+			"ibm_cis_firewall":                             cis.ResourceIBMCISFirewallRecord(),
+			"ibm_cis_range_app":                            cis.ResourceIBMCISRangeApp(),
+			"ibm_cis_healthcheck":                          cis.ResourceIBMCISHealthCheck(),
+			"ibm_cis_origin_pool":                          cis.ResourceIBMCISPool(),
+			"ibm_cis_global_load_balancer":                 cis.ResourceIBMCISGlb(),
+			"ibm_cis_certificate_upload":                   cis.ResourceIBMCISCertificateUpload(),
+			"ibm_cis_dns_record":                           cis.ResourceIBMCISDnsRecord(),
+			"ibm_cis_dns_records_import":                   cis.ResourceIBMCISDNSRecordsImport(),
+			"ibm_cis_rate_limit":                           cis.ResourceIBMCISRateLimit(),
+			"ibm_cis_page_rule":                            cis.ResourceIBMCISPageRule(),
+			"ibm_cis_edge_functions_action":                cis.ResourceIBMCISEdgeFunctionsAction(),
+			"ibm_cis_edge_functions_trigger":               cis.ResourceIBMCISEdgeFunctionsTrigger(),
+			"ibm_cis_tls_settings":                         cis.ResourceIBMCISTLSSettings(),
+			"ibm_cis_waf_package":                          cis.ResourceIBMCISWAFPackage(),
+			"ibm_cis_webhook":                              cis.ResourceIBMCISWebhooks(),
+			"ibm_cis_logpush_job":                          cis.ResourceIBMCISLogPushJob(),
+			"ibm_cis_alert":                                cis.ResourceIBMCISAlert(),
+			"ibm_cis_routing":                              cis.ResourceIBMCISRouting(),
+			"ibm_cis_waf_group":                            cis.ResourceIBMCISWAFGroup(),
+			"ibm_cis_cache_settings":                       cis.ResourceIBMCISCacheSettings(),
+			"ibm_cis_custom_page":                          cis.ResourceIBMCISCustomPage(),
+			"ibm_cis_waf_rule":                             cis.ResourceIBMCISWAFRule(),
+			"ibm_cis_certificate_order":                    cis.ResourceIBMCISCertificateOrder(),
+			"ibm_cis_filter":                               cis.ResourceIBMCISFilter(),
+			"ibm_cis_firewall_rule":                        cis.ResourceIBMCISFirewallrules(),
+			"ibm_cloudant":                                 cloudant.ResourceIBMCloudant(),
+			"ibm_cloudant_database":                        cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_design_document":                 cloudant.ResourceIBMCloudantDesignDocument(),
+			"ibm_cloudant_index":                           cloudant.ResourceIBMCloudantIndex(),
+			"ibm_cloudant_replication":                     cloudant.ResourceIBMCloudantReplication(),
+			"ibm_cloud_shell_account_settings":             cloudshell.ResourceIBMCloudShellAccountSettings(),
+			"ibm_compute_autoscale_group":                  classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
+			"ibm_compute_autoscale_policy":                 classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
+			"ibm_compute_bare_metal":                       classicinfrastructure.ResourceIBMComputeBareMetal(),
+			"ibm_compute_dedicated_host":                   classicinfrastructure.ResourceIBMComputeDedicatedHost(),
+			"ibm_compute_monitor":                          classicinfrastructure.ResourceIBMComputeMonitor(),
+			"ibm_compute_placement_group":                  classicinfrastructure.ResourceIBMComputePlacementGroup(),
+			"ibm_compute_reserved_capacity":                classicinfrastructure.ResourceIBMComputeReservedCapacity(),
+			"ibm_compute_provisioning_hook":                classicinfrastructure.ResourceIBMComputeProvisioningHook(),
+			"ibm_compute_ssh_key":                          classicinfrastructure.ResourceIBMComputeSSHKey(),
+			"ibm_compute_ssl_certificate":                  classicinfrastructure.ResourceIBMComputeSSLCertificate(),
+			"ibm_compute_user":                             classicinfrastructure.ResourceIBMComputeUser(),
+			"ibm_compute_vm_instance":                      classicinfrastructure.ResourceIBMComputeVmInstance(),
+			"ibm_container_addons":                         kubernetes.ResourceIBMContainerAddOns(),
+			"ibm_container_alb":                            kubernetes.ResourceIBMContainerALB(),
+			"ibm_container_alb_create":                     kubernetes.ResourceIBMContainerAlbCreate(),
+			"ibm_container_api_key_reset":                  kubernetes.ResourceIBMContainerAPIKeyReset(),
+			"ibm_container_vpc_alb":                        kubernetes.ResourceIBMContainerVpcALB(),
+			"ibm_container_vpc_alb_create":                 kubernetes.ResourceIBMContainerVpcAlbCreateNew(),
+			"ibm_container_vpc_worker_pool":                kubernetes.ResourceIBMContainerVpcWorkerPool(),
+			"ibm_container_vpc_cluster":                    kubernetes.ResourceIBMContainerVpcCluster(),
+			"ibm_container_alb_cert":                       kubernetes.ResourceIBMContainerALBCert(),
+			"ibm_container_cluster":                        kubernetes.ResourceIBMContainerCluster(),
+			"ibm_container_cluster_feature":                kubernetes.ResourceIBMContainerClusterFeature(),
+			"ibm_container_bind_service":                   kubernetes.ResourceIBMContainerBindService(),
+			"ibm_container_worker_pool":                    kubernetes.ResourceIBMContainerWorkerPool(),
+			"ibm_container_worker_pool_zone_attachment":    kubernetes.ResourceIBMContainerWorkerPoolZoneAttachment(),
+			"ibm_container_storage_attachment":             kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachment(),
+			"ibm_container_nlb_dns":                        kubernetes.ResourceIBMContainerNlbDns(),
+			"ibm_cr_namespace":                             registry.ResourceIBMCrNamespace(),
+			"ibm_cr_retention_policy":                      registry.ResourceIBMCrRetentionPolicy(),
+			"ibm_ob_logging":                               kubernetes.ResourceIBMObLogging(),
+			"ibm_ob_monitoring":                            kubernetes.ResourceIBMObMonitoring(),
+			"ibm_cos_bucket":                               cos.ResourceIBMCOSBucket(),
+			"ibm_cos_bucket_object":                        cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_directory":                     cos.ResourceIBMCOSBucketDirectory(),
+			"ibm_dns_domain":                               classicinfrastructure.ResourceIBMDNSDomain(),
+			"ibm_dns_domain_registration_nameservers":      classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
+			"ibm_dns_secondary":                            classicinfrastructure.ResourceIBMDNSSecondary(),
+			"ibm_dns_record":                               classicinfrastructure.ResourceIBMDNSRecord(),
+			"ibm_event_streams_topic":                      eventstreams.ResourceIBMEventStreamsTopic(),
+			"ibm_event_streams_schema":                     eventstreams.ResourceIBMEventStreamsSchema(),
+			"ibm_event_streams_acl":                        eventstreams.ResourceIBMEventStreamsACL(),
+			"ibm_event_streams_quota":                      eventstreams.ResourceIBMEventStreamsQuota(),
+			"ibm_event_streams_mirroring_config":           eventstreams.ResourceIBMEventStreamsMirroringConfig(),
+			"ibm_firewall":                                 classicinfrastructure.ResourceIBMFirewall(),
+			"ibm_firewall_policy":                          classicinfrastructure.ResourceIBMFirewallPolicy(),
+			"ibm_hpcs":                                     hpcs.ResourceIBMHPCS(),
+			"ibm_iam_access_group":                         iamaccessgroup.ResourceIBMIAMAccessGroup(),
+			"ibm_iam_account_settings":                     iamidentity.ResourceIBMIAMAccountSettings(),
+			"ibm_iam_account_settings_template":            iamidentity.ResourceIBMIAMAccountSettingsTemplate(),
+			"ibm_iam_account_settings_template_version":    iamidentity.ResourceIBMIAMAccountSettingsTemplateVersion(),
+			"ibm_iam_account_settings_template_assignment": iamidentity.ResourceIBMIAMAccountSettingsTemplateAssignment(),
+			"ibm_iam_custom_role":                          iampolicy.ResourceIBMIAMCustomRole(),
+			"ibm_iam_access_group_dynamic_rule":            iamaccessgroup.ResourceIBMIAMDynamicRule(),
+			"ibm_iam_access_group_members":                 iamaccessgroup.ResourceIBMIAMAccessGroupMembers(),
+			"ibm_iam_access_group_policy":                  iampolicy.ResourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_group_policies_exclusive":      iampolicy.ResourceIBMIAMAccessGroupPoliciesExclusive(),
+			"ibm_iam_authorization_policy":                 iampolicy.ResourceIBMIAMAuthorizationPolicy(),
+			"ibm_iam_authorization_policy_detach":          iampolicy.ResourceIBMIAMAuthorizationPolicyDetach(),
+			"ibm_iam_user_policy":                          iampolicy.ResourceIBMIAMUserPolicy(),
+			"ibm_iam_user_settings":                        iamidentity.ResourceIBMIAMUserSettings(),
+			"ibm_iam_service_id":                           iamidentity.ResourceIBMIAMServiceID(),
+			"ibm_iam_service_api_key":                      iamidentity.ResourceIBMIAMServiceAPIKey(),
+			"ibm_iam_service_policy":                       iampolicy.ResourceIBMIAMServicePolicy(),
+			"ibm_iam_user_invite":                          iampolicy.ResourceIBMIAMUserInvite(),
+			"ibm_iam_api_key":                              iamidentity.ResourceIBMIAMApiKey(),
+			"ibm_iam_trusted_profile":                      iamidentity.ResourceIBMIAMTrustedProfile(),
+			"ibm_iam_trusted_profile_claim_rule":           iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
+			"ibm_iam_trusted_profile_link":                 iamidentity.ResourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_template":             iamidentity.ResourceIBMIAMTrustedProfileTemplate(),
+			"ibm_iam_trusted_profile_template_version":     iamidentity.ResourceIBMIAMTrustedProfileTemplateVersion(),
+			"ibm_iam_trusted_profile_template_assignment":  iamidentity.ResourceIBMIAMTrustedProfileTemplateAssignment(),
+			"ibm_iam_trusted_profile_policy":               iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_policy_template":                      iampolicy.ResourceIBMIAMPolicyTemplate(),
+			"ibm_iam_policy_template_version":              iampolicy.ResourceIBMIAMPolicyTemplateVersion(),
+			"ibm_iam_policy_assignment":                    iampolicy.ResourceIBMIAMPolicyAssignment(),
+			"ibm_ipsec_vpn":                                classicinfrastructure.ResourceIBMIPSecVPN(),
 
 			// bare_metal_server
 			"ibm_is_bare_metal_server_action":                        vpc.ResourceIBMIsBareMetalServerAction(),
@@ -857,10 +897,18 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                                  kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                               kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_instance_policies":                          kms.ResourceIBMKmsInstancePolicies(),
+			"ibm_kms_key_deletion_authorization":                 kms.ResourceIBMKmsKeyDeletionAuthorization(),
+			"ibm_kms_key_rotation":                               kms.ResourceIBMKmsKeyRotation(),
+			"ibm_kms_import_token":                               kms.ResourceIBMKmsImportToken(),
+			"ibm_kms_kmip_adapter":                               kms.ResourceIBMKmsKmipAdapter(),
+			"ibm_kms_kmip_client_cert":                           kms.ResourceIBMKmsKmipClientCertificate(),
 			"ibm_kp_key":                                         kms.ResourceIBMkey(),
 			"ibm_resource_group":                                 resourcemanager.ResourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourcecontroller.ResourceIBMResourceInstance(),
 			"ibm_resource_key":                                   resourcecontroller.ResourceIBMResourceKey(),
+			"ibm_resource_alias":                                 resourcecontroller.ResourceIBMResourceAlias(),
+			"ibm_resource_binding":                               resourcecontroller.ResourceIBMResourceBinding(),
 			"ibm_security_group":                                 classicinfrastructure.ResourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                            classicinfrastructure.ResourceIBMSecurityGroupRule(),
 			"ibm_service_instance":                               cloudfoundry.ResourceIBMServiceInstance(),
@@ -922,10 +970,15 @@ func Provider() *schema.Provider {
 			"ibm_tg_route_report":             transitgateway.ResourceIBMTransitGatewayRouteReport(),
 
 			// //Catalog related resources
-			"ibm_cm_offering_instance": catalogmanagement.ResourceIBMCmOfferingInstance(),
-			"ibm_cm_catalog":           catalogmanagement.ResourceIBMCmCatalog(),
-			"ibm_cm_offering":          catalogmanagement.ResourceIBMCmOffering(),
-			"ibm_cm_version":           catalogmanagement.ResourceIBMCmVersion(),
+			"ibm_cm_offering_instance":  catalogmanagement.ResourceIBMCmOfferingInstance(),
+			"ibm_cm_catalog":            catalogmanagement.ResourceIBMCmCatalog(),
+			"ibm_cm_offering":           catalogmanagement.ResourceIBMCmOffering(),
+			"ibm_cm_version":            catalogmanagement.ResourceIBMCmVersion(),
+			"ibm_cm_version_validation": catalogmanagement.ResourceIBMCmVersionValidation(),
+			"ibm_cm_offering_publish":   catalogmanagement.ResourceIBMCmOfferingPublish(),
+			"ibm_cm_object":             catalogmanagement.ResourceIBMCmObject(),
+			"ibm_cm_account":            catalogmanagement.ResourceIBMCmAccount(),
+			"ibm_cm_catalog_access":     catalogmanagement.ResourceIBMCmCatalogAccess(),
 
 			// //Added for enterprise
 			"ibm_enterprise":               enterprise.ResourceIBMEnterprise(),
@@ -936,6 +989,10 @@ func Provider() *schema.Provider {
 			"ibm_schematics_workspace":      schematics.ResourceIBMSchematicsWorkspace(),
 			"ibm_schematics_action":         schematics.ResourceIBMSchematicsAction(),
 			"ibm_schematics_job":            schematics.ResourceIBMSchematicsJob(),
+			"ibm_schematics_workspace_run":  schematics.ResourceIBMSchematicsWorkspaceRun(),
+			"ibm_schematics_agent":          schematics.ResourceIBMSchematicsAgent(),
+			"ibm_schematics_agent_deploy":   schematics.ResourceIBMSchematicsAgentDeploy(),
+			"ibm_schematics_policy":         schematics.ResourceIBMSchematicsPolicy(),
 			"ibm_schematics_inventory":      schematics.ResourceIBMSchematicsInventory(),
 			"ibm_schematics_resource_query": schematics.ResourceIBMSchematicsResourceQuery(),
 
@@ -1105,6 +1162,10 @@ func Validator() validate.ValidatorDict {
 				"ibm_schematics_action":                   schematics.ResourceIBMSchematicsActionValidator(),
 				"ibm_schematics_job":                      schematics.ResourceIBMSchematicsJobValidator(),
 				"ibm_schematics_workspace":                schematics.ResourceIBMSchematicsWorkspaceValidator(),
+				"ibm_schematics_workspace_run":            schematics.ResourceIBMSchematicsWorkspaceRunValidator(),
+				"ibm_schematics_agent":                    schematics.ResourceIBMSchematicsAgentValidator(),
+				"ibm_schematics_policy":                   schematics.ResourceIBMSchematicsPolicyValidator(),
+				"ibm_cm_offering_publish":                 catalogmanagement.ResourceIBMCmOfferingPublishValidator(),
 				"ibm_schematics_inventory":                schematics.ResourceIBMSchematicsInventoryValidator(),
 				"ibm_schematics_resource_query":           schematics.ResourceIBMSchematicsResourceQueryValidator(),
 				"ibm_resource_instance":                   resourcecontroller.ResourceIBMResourceInstanceValidator(),
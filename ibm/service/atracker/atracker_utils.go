@@ -1,9 +1,16 @@
 package atracker
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 const (
@@ -28,3 +35,103 @@ func getAtrackerClients(meta interface{}) (
 
 	return atrackerClientv1, atrackerClientv2, nil
 }
+
+const (
+	atrackerTargetTypeEventStreams = "event_streams"
+	atrackerTargetTypeCloudLogs    = "cloud_logs"
+
+	atrackerWriteStatusSuccess = "success"
+)
+
+// atrackerTarget is the target of the API with the Event Streams and Cloud
+// Logs endpoints, which the SDK model doesn't carry
+type atrackerTarget struct {
+	atrackerv2.Target
+	EventstreamsEndpoint *atrackerEventstreamsEndpoint `json:"eventstreams_endpoint,omitempty"`
+	CloudlogsEndpoint    *atrackerCloudlogsEndpoint    `json:"cloudlogs_endpoint,omitempty"`
+}
+
+type atrackerEventstreamsEndpoint struct {
+	TargetCRN               *string  `json:"target_crn"`
+	Brokers                 []string `json:"brokers"`
+	Topic                   *string  `json:"topic"`
+	APIKey                  *string  `json:"api_key,omitempty"`
+	ServiceToServiceEnabled *bool    `json:"service_to_service_enabled,omitempty"`
+}
+
+type atrackerCloudlogsEndpoint struct {
+	TargetCRN *string `json:"target_crn"`
+}
+
+// atrackerRule is the rule of a route with the event types filter, which the
+// SDK model doesn't carry
+type atrackerRule struct {
+	atrackerv2.Rule
+	EventTypes []string `json:"event_types,omitempty"`
+}
+
+type atrackerRulePrototype struct {
+	atrackerv2.RulePrototype
+	EventTypes []string `json:"event_types,omitempty"`
+}
+
+type atrackerRoute struct {
+	atrackerv2.Route
+	Rules []atrackerRule `json:"rules"`
+}
+
+// atrackerRequest sends a request to the atracker API and decodes the response in result
+func atrackerRequest(context context.Context, client *atrackerv2.AtrackerV2, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// waitForAtrackerTargetHealthy validates the target id until its write status
+// is success. A failed write status is retried until the timeout, as the
+// permissions of a new target may take a while to propagate.
+func waitForAtrackerTargetHealthy(context context.Context, client *atrackerv2.AtrackerV2, id string, timeout time.Duration) error {
+	lastFailure := ""
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{atrackerWriteStatusSuccess},
+		Refresh: func() (interface{}, string, error) {
+			target := &atrackerTarget{}
+			response, err := atrackerRequest(context, client, core.POST, "/api/v2/targets/{id}/validate", map[string]string{"id": id}, nil, target)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error validating target (%s): %s\n%s", id, err, response)
+			}
+			if target.WriteStatus != nil && target.WriteStatus.Status != nil && *target.WriteStatus.Status == atrackerWriteStatusSuccess {
+				return target, atrackerWriteStatusSuccess, nil
+			}
+			if target.WriteStatus != nil && target.WriteStatus.ReasonForLastFailure != nil {
+				lastFailure = *target.WriteStatus.ReasonForLastFailure
+			}
+			log.Printf("[DEBUG] Target (%s) can't be written to yet: %s", id, lastFailure)
+			return target, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for target (%s) to be healthy, last failure %q: %s", id, lastFailure, err)
+	}
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 	"github.com/IBM/platform-services-go-sdk/atrackerv2"
 )
@@ -57,6 +58,12 @@ func ResourceIBMAtrackerRoute() *schema.Resource {
 							Description: "Logs from these locations will be sent to the targets specified. Locations is a superset of regions including global and *.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"event_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Only the events of these types, such as `iam-identity.apikey.create` or `cloud-object-storage.*`, are sent to the targets specified. All the events are sent when not set.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -125,19 +132,20 @@ func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
-	createRouteOptions := &atrackerv2.CreateRouteOptions{}
-
-	createRouteOptions.SetName(d.Get("name").(string))
-	var rules []atrackerv2.RulePrototype
+	// The route is sent with a raw request, as the SDK rules don't carry the event types
+	var rules []atrackerRulePrototype
 	for _, e := range d.Get("rules").([]interface{}) {
 		value := e.(map[string]interface{})
 		rulesItem := resourceIBMAtrackerRouteMapToRule(value, d.Get("receive_global_events").(bool))
 		rules = append(rules, rulesItem)
 	}
+	body := map[string]interface{}{
+		"name":  d.Get("name").(string),
+		"rules": rules,
+	}
 
-	createRouteOptions.SetRules(rules)
-
-	route, response, err := atrackerClient.CreateRouteWithContext(context, createRouteOptions)
+	route := &atrackerRoute{}
+	response, err := atrackerRequest(context, atrackerClient, core.POST, "/api/v2/routes", nil, body, route)
 	if err != nil {
 		log.Printf("[DEBUG] CreateRouteWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
@@ -156,12 +164,11 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	getRouteOptions := &atrackerv2.GetRouteOptions{}
-	getRouteOptions.SetID(d.Id())
 	apiVersion := d.Get("api_version")
 
 	// Try v2 first, otherwise try v1
-	route, response, err := atrackerClient.GetRouteWithContext(context, getRouteOptions)
+	route := &atrackerRoute{}
+	response, err := atrackerRequest(context, atrackerClient, core.GET, "/api/v2/routes/{id}", map[string]string{"id": d.Id()}, nil, route)
 
 	if err != nil && response != nil && response.StatusCode != 404 {
 		log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, response)
@@ -249,20 +256,18 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 	apiVersion := d.Get("api_version").(int)
 
 	if apiVersion > 1 {
-		replaceRouteOptions := &atrackerv2.ReplaceRouteOptions{}
-
-		replaceRouteOptions.SetID(d.Id())
-		replaceRouteOptions.SetName(d.Get("name").(string))
-
-		var rules []atrackerv2.RulePrototype = make([]atrackerv2.RulePrototype, 0)
+		var rules []atrackerRulePrototype = make([]atrackerRulePrototype, 0)
 		for _, e := range d.Get("rules").([]interface{}) {
 			value := e.(map[string]interface{})
 			rulesItem := resourceIBMAtrackerRouteMapToRule(value, d.Get("receive_global_events").(bool))
 			rules = append(rules, rulesItem)
 		}
-		replaceRouteOptions.SetRules(rules)
+		body := map[string]interface{}{
+			"name":  d.Get("name").(string),
+			"rules": rules,
+		}
 
-		_, response, err := atrackerClient.ReplaceRouteWithContext(context, replaceRouteOptions)
+		response, err := atrackerRequest(context, atrackerClient, core.PUT, "/api/v2/routes/{id}", map[string]string{"id": d.Id()}, body, &atrackerRoute{})
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
@@ -278,6 +283,9 @@ func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceD
 	var rules []atrackerv1.Rule = make([]atrackerv1.Rule, 0)
 	for _, e := range d.Get("rules").([]interface{}) {
 		value := e.(map[string]interface{})
+		if eventTypes, ok := value["event_types"].(*schema.Set); ok && eventTypes.Len() > 0 {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating route (%s): event_types is only supported by version 2 routes", d.Id()))
+		}
 		rulesItem := resourceIBMAtrackerRouteMapToRuleV1(value)
 		rules = append(rules, rulesItem)
 	}
@@ -327,7 +335,7 @@ func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceIBMAtrackerRouteRulePrototypeToMap(ruleModel *atrackerv2.Rule) (map[string]interface{}, bool, error) {
+func resourceIBMAtrackerRouteRulePrototypeToMap(ruleModel *atrackerRule) (map[string]interface{}, bool, error) {
 	receives_global_events := false
 	ruleMap := make(map[string]interface{})
 	if ruleModel != nil {
@@ -347,6 +355,7 @@ func resourceIBMAtrackerRouteRulePrototypeToMap(ruleModel *atrackerv2.Rule) (map
 				}
 			}
 		}
+		ruleMap["event_types"] = ruleModel.EventTypes
 		return ruleMap, receives_global_events, nil
 	}
 	return ruleMap, false, nil
@@ -358,8 +367,8 @@ func resourceIBMAtrackerRouteRulePrototypeToMapV1(model *atrackerv1.Rule) (map[s
 	return modelMap, nil
 }
 
-func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}, addGlobalFlag bool) atrackerv2.RulePrototype {
-	rule := atrackerv2.RulePrototype{}
+func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}, addGlobalFlag bool) atrackerRulePrototype {
+	rule := atrackerRulePrototype{}
 
 	targetIds := make([]string, 0)
 	for _, targetIdsItem := range ruleMap["target_ids"].(*schema.Set).List() {
@@ -383,6 +392,12 @@ func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}, addGlobal
 	}
 	rule.Locations = locations
 
+	if eventTypes, ok := ruleMap["event_types"].(*schema.Set); ok {
+		for _, eventTypesItem := range eventTypes.List() {
+			rule.EventTypes = append(rule.EventTypes, eventTypesItem.(string))
+		}
+	}
+
 	return rule
 }
 
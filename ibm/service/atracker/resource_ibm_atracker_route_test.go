@@ -71,6 +71,62 @@ func testAccCheckIBMAtrackerRouteConfigBasic(name string) string {
 	`, name)
 }
 
+func TestAccIBMAtrackerRouteEventTypes(t *testing.T) {
+	var conf atrackerv2.Route
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerRouteConfigEventTypes(name, "iam-identity.apikey.create"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAtrackerRouteExists("ibm_atracker_route.atracker_route", conf),
+					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "rules.0.event_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr("ibm_atracker_route.atracker_route", "rules.0.event_types.*", "iam-identity.apikey.create"),
+				),
+			},
+			{
+				Config: testAccCheckIBMAtrackerRouteConfigEventTypes(name, "cloud-object-storage.*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("ibm_atracker_route.atracker_route", "rules.0.event_types.*", "cloud-object-storage.*"),
+				),
+			},
+			{
+				ResourceName:      "ibm_atracker_route.atracker_route",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerRouteConfigEventTypes(name, eventType string) string {
+	return fmt.Sprintf(`
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "my-cos-target"
+			target_type = "cloud_object_storage"
+			cos_endpoint {
+				endpoint = "s3.private.us-east.cloud-object-storage.appdomain.cloud"
+				target_crn = "crn:v1:bluemix:public:cloud-object-storage:global:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				bucket = "my-atracker-bucket"
+				api_key = "xxxxxxxxxxxxxx"
+			}
+		}
+
+		resource "ibm_atracker_route" "atracker_route" {
+			name = "%s"
+			rules {
+				target_ids = [ ibm_atracker_target.atracker_target.id ]
+				locations = [ "us-south" ]
+				event_types = [ "%s" ]
+			}
+		}
+	`, name, eventType)
+}
+
 func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerv2.Route) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
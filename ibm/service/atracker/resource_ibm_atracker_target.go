@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,11 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 		DeleteContext: resourceIBMAtrackerTargetDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -41,7 +47,7 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 				Required:         true,
 				ForceNew:         true,
 				ValidateFunc:     validate.InvokeValidator("ibm_atracker_target", "target_type"),
-				Description:      "The type of the target. It can be cloud_object_storage or logdna. Based on this type you must include cos_endpoint or logdna_endpoint.",
+				Description:      "The type of the target. It can be cloud_object_storage, logdna, event_streams or cloud_logs. Based on this type you must include cos_endpoint, logdna_endpoint, eventstreams_endpoint or cloudlogs_endpoint.",
 			},
 			"cos_endpoint": {
 				Type:        schema.TypeList,
@@ -102,6 +108,65 @@ func ResourceIBMAtrackerTarget() *schema.Resource {
 					},
 				},
 			},
+			"eventstreams_endpoint": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Property values for an Event Streams Endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the Event Streams instance.",
+						},
+						"brokers": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of broker endpoints.",
+						},
+						"topic": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The messsage hub topic defined in the Event Streams instance.",
+						},
+						"api_key": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: flex.ApplyOnce,
+							Description:      "The user password (api key) for the message hub topic in the Event Streams instance. This is required if service_to_service is not enabled.",
+						},
+						"service_to_service_enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Determines if IBM Cloud Activity Tracker Event Routing has service to service authentication enabled. Set this flag to true if service to service is enabled and do not supply an apikey.",
+						},
+					},
+				},
+			},
+			"cloudlogs_endpoint": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Property values for an IBM Cloud Logs endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the IBM Cloud Logs instance.",
+						},
+					},
+				},
+			},
+			"wait_until_healthy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Waits until a test event is written to the target successfully when the target is created or its endpoint changes.",
+			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -222,7 +287,7 @@ func ResourceIBMAtrackerTargetValidator() *validate.ResourceValidator {
 			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
 			Type:                       validate.TypeString,
 			Required:                   true,
-			AllowedValues:              "cloud_object_storage, logdna",
+			AllowedValues:              "cloud_logs, cloud_object_storage, event_streams, logdna",
 		},
 		validate.ValidateSchema{
 			Identifier:                 "region",
@@ -267,14 +332,26 @@ func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.Resource
 		createTargetOptions.SetRegion(d.Get("region").(string))
 	}
 
-	target, response, err := atrackerClient.CreateTargetWithContext(context, createTargetOptions)
-	if err != nil {
+	body := resourceIBMAtrackerTargetBody(d, createTargetOptions.Name, createTargetOptions.CosEndpoint, createTargetOptions.LogdnaEndpoint)
+	body["target_type"] = createTargetOptions.TargetType
+	if createTargetOptions.Region != nil {
+		body["region"] = createTargetOptions.Region
+	}
+	target := &atrackerTarget{}
+	response, err := atrackerRequest(context, atrackerClient, core.POST, "/api/v2/targets", nil, body, target)
+	if err != nil || target.ID == nil {
 		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
 	}
 
 	d.SetId(*target.ID)
 
+	if d.Get("wait_until_healthy").(bool) {
+		if err = waitForAtrackerTargetHealthy(context, atrackerClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
@@ -284,11 +361,8 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	getTargetOptions := &atrackerv2.GetTargetOptions{}
-
-	getTargetOptions.SetID(d.Id())
-
-	target, response, err := atrackerClient.GetTargetWithContext(context, getTargetOptions)
+	target := &atrackerTarget{}
+	response, err := atrackerRequest(context, atrackerClient, core.GET, "/api/v2/targets/{id}", map[string]string{"id": d.Id()}, nil, target)
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -341,6 +415,20 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if target.EventstreamsEndpoint != nil {
+		if err = d.Set("eventstreams_endpoint", []map[string]interface{}{resourceIBMAtrackerTargetEventstreamsEndpointToMap(target.EventstreamsEndpoint)}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting eventstreams_endpoint: %s", err))
+		}
+	}
+	if target.CloudlogsEndpoint != nil {
+		cloudlogsEndpointMap := map[string]interface{}{
+			"target_crn": target.CloudlogsEndpoint.TargetCRN,
+		}
+		if err = d.Set("cloudlogs_endpoint", []map[string]interface{}{cloudlogsEndpointMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting cloudlogs_endpoint: %s", err))
+		}
+	}
+
 	if target.CRN != nil {
 		if err = d.Set("crn", target.CRN); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
@@ -356,17 +444,24 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 		}
 	}
 
-	writeStatusMap, err := resourceIBMAtrackerTargetWriteStatusToMap(target.WriteStatus)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	if err = d.Set("write_status", []map[string]interface{}{writeStatusMap}); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting write_status: %s", err))
+	if target.WriteStatus != nil {
+		writeStatusMap, err := resourceIBMAtrackerTargetWriteStatusToMap(target.WriteStatus)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("write_status", []map[string]interface{}{writeStatusMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting write_status: %s", err))
+		}
+
+		// TODO: will be removed
+		if err = d.Set("cos_write_status", []map[string]interface{}{writeStatusMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting cos_write_status: %s", err))
+		}
 	}
 
-	// TODO: will be removed
-	if err = d.Set("cos_write_status", []map[string]interface{}{writeStatusMap}); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting cos_write_status: %s", err))
+	// Default of the argument for imported targets
+	if _, ok := d.GetOk("wait_until_healthy"); !ok {
+		d.Set("wait_until_healthy", false)
 	}
 
 	if err = d.Set("created_at", flex.DateTimeToString(target.CreatedAt)); err != nil {
@@ -394,7 +489,8 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 
 	hasChange := false
 
-	if d.HasChange("name") || d.HasChange("cos_endpoint") || d.HasChange("region") || d.HasChange("logdna_endpoint") {
+	endpointChange := d.HasChange("cos_endpoint") || d.HasChange("logdna_endpoint") || d.HasChange("eventstreams_endpoint") || d.HasChange("cloudlogs_endpoint")
+	if d.HasChange("name") || d.HasChange("region") || endpointChange {
 		replaceTargetOptions.SetName(d.Get("name").(string))
 
 		_, hasCosEndpoint := d.GetOk("cos_endpoint.0")
@@ -418,13 +514,20 @@ func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.Resource
 	}
 
 	if hasChange {
-		_, response, err := atrackerClient.ReplaceTargetWithContext(context, replaceTargetOptions)
+		body := resourceIBMAtrackerTargetBody(d, replaceTargetOptions.Name, replaceTargetOptions.CosEndpoint, replaceTargetOptions.LogdnaEndpoint)
+		response, err := atrackerRequest(context, atrackerClient, core.PUT, "/api/v2/targets/{id}", map[string]string{"id": d.Id()}, body, &atrackerTarget{})
 		if err != nil {
 			log.Printf("[DEBUG] ReplaceTargetWithContext failed %s\n%s", err, response)
 			return diag.FromErr(fmt.Errorf("ReplaceTargetWithContext failed %s\n%s", err, response))
 		}
 	}
 
+	if d.Get("wait_until_healthy").(bool) && (endpointChange || d.HasChange("wait_until_healthy")) {
+		if err = waitForAtrackerTargetHealthy(context, atrackerClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
@@ -449,6 +552,52 @@ func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.Resource
 	return nil
 }
 
+// resourceIBMAtrackerTargetBody builds the body of a create or replace
+// request, the Event Streams and Cloud Logs endpoints aren't in the SDK options
+func resourceIBMAtrackerTargetBody(d *schema.ResourceData, name *string, cosEndpoint *atrackerv2.CosEndpointPrototype, logdnaEndpoint *atrackerv2.LogdnaEndpointPrototype) map[string]interface{} {
+	body := map[string]interface{}{
+		"name": name,
+	}
+	if cosEndpoint != nil {
+		body["cos_endpoint"] = cosEndpoint
+	}
+	if logdnaEndpoint != nil {
+		body["logdna_endpoint"] = logdnaEndpoint
+	}
+	if _, ok := d.GetOk("eventstreams_endpoint.0"); ok {
+		body["eventstreams_endpoint"] = resourceIBMAtrackerTargetMapToEventstreamsEndpoint(d.Get("eventstreams_endpoint.0").(map[string]interface{}))
+	}
+	if _, ok := d.GetOk("cloudlogs_endpoint.0"); ok {
+		modelMap := d.Get("cloudlogs_endpoint.0").(map[string]interface{})
+		body["cloudlogs_endpoint"] = &atrackerCloudlogsEndpoint{
+			TargetCRN: core.StringPtr(modelMap["target_crn"].(string)),
+		}
+	}
+	return body
+}
+
+func resourceIBMAtrackerTargetMapToEventstreamsEndpoint(modelMap map[string]interface{}) *atrackerEventstreamsEndpoint {
+	model := &atrackerEventstreamsEndpoint{}
+	model.TargetCRN = core.StringPtr(modelMap["target_crn"].(string))
+	model.Brokers = flex.ExpandStringList(modelMap["brokers"].([]interface{}))
+	model.Topic = core.StringPtr(modelMap["topic"].(string))
+	if modelMap["api_key"] != nil && modelMap["api_key"].(string) != "" {
+		model.APIKey = core.StringPtr(modelMap["api_key"].(string))
+	}
+	model.ServiceToServiceEnabled = core.BoolPtr(modelMap["service_to_service_enabled"].(bool))
+	return model
+}
+
+func resourceIBMAtrackerTargetEventstreamsEndpointToMap(model *atrackerEventstreamsEndpoint) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	modelMap["target_crn"] = model.TargetCRN
+	modelMap["brokers"] = model.Brokers
+	modelMap["topic"] = model.Topic
+	modelMap["api_key"] = REDACTED_TEXT // pragma: whitelist secret
+	modelMap["service_to_service_enabled"] = model.ServiceToServiceEnabled
+	return modelMap
+}
+
 func resourceIBMAtrackerTargetMapToCosEndpointPrototype(modelMap map[string]interface{}) (*atrackerv2.CosEndpointPrototype, error) {
 	model := &atrackerv2.CosEndpointPrototype{}
 	model.Endpoint = core.StringPtr(modelMap["endpoint"].(string))
@@ -51,6 +51,92 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMAtrackerTargetEventStreams(t *testing.T) {
+	var conf atrackerv2.Target
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigEventStreams(name, "my-topic"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAtrackerTargetExists("ibm_atracker_target.atracker_target", conf),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "target_type", "event_streams"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "eventstreams_endpoint.0.topic", "my-topic"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "eventstreams_endpoint.0.brokers.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigEventStreams(name, "my-other-topic"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "eventstreams_endpoint.0.topic", "my-other-topic"),
+				),
+			},
+			{
+				ResourceName:      "ibm_atracker_target.atracker_target",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMAtrackerTargetCloudLogs(t *testing.T) {
+	var conf atrackerv2.Target
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAtrackerTargetConfigCloudLogs(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAtrackerTargetExists("ibm_atracker_target.atracker_target", conf),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "target_type", "cloud_logs"),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "cloudlogs_endpoint.0.target_crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerTargetConfigEventStreams(name string, topic string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "event_streams"
+			eventstreams_endpoint {
+				target_crn = "crn:v1:bluemix:public:messagehub:us-south:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				brokers = [
+					"kafka-x:9094",
+					"kafka-y:9094",
+				]
+				topic = "%s"
+				api_key = "xxxxxxxxxxxxxx"
+			}
+		}
+	`, name, topic)
+}
+
+func testAccCheckIBMAtrackerTargetConfigCloudLogs(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "cloud_logs"
+			cloudlogs_endpoint {
+				target_crn = "crn:v1:bluemix:public:logs:us-south:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+			}
+		}
+	`, name)
+}
+
 func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) string {
 	return fmt.Sprintf(`
 
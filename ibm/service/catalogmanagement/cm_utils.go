@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	cmVersionStatePublished = "published"
+
+	cmValidationStateValid      = "valid"
+	cmValidationStateInvalid    = "invalid"
+	cmValidationStateInProgress = "in_progress"
+
+	// cmEnterpriseAccessPrefix prefixes the enterprises in access lists
+	cmEnterpriseAccessPrefix = "-ent-"
+)
+
+// cmBadge is a badge of an offering, which the SDK model doesn't carry
+type cmBadge struct {
+	ID          *string `json:"id,omitempty"`
+	Label       *string `json:"label,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+	Authority   *string `json:"authority,omitempty"`
+	Tag         *string `json:"tag,omitempty"`
+}
+
+// cmRequest sends a request that the catalog management SDK doesn't cover
+// and decodes the response in result
+func cmRequest(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, method, path string, pathParams map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = catalogManagementClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(catalogManagementClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return catalogManagementClient.Service.Request(request, result)
+}
+
+// cmOptionalString returns the string of key in m, or nil when it is empty
+func cmOptionalString(m map[string]interface{}, key string) *string {
+	if value, ok := m[key].(string); ok && value != "" {
+		return core.StringPtr(value)
+	}
+	return nil
+}
+
+// cmEnterpriseAccess returns the access list entry of an enterprise
+func cmEnterpriseAccess(enterpriseID string) string {
+	return cmEnterpriseAccessPrefix + enterpriseID
+}
+
+// getCmOfferingBadges returns the badges of the offering
+func getCmOfferingBadges(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, catalogID, offeringID string) ([]cmBadge, error) {
+	offering := &struct {
+		Badges []cmBadge `json:"badges"`
+	}{}
+	pathParams := map[string]string{"catalog_identifier": catalogID, "offering_id": offeringID}
+	response, err := cmRequest(catalogManagementClient, core.GET, "/catalogs/{catalog_identifier}/offerings/{offering_id}", pathParams, nil, offering)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting badges of offering (%s): %s\n%s", offeringID, err, response)
+	}
+	return offering.Badges, nil
+}
+
+// waitForCmVersion polls the version until done tells that the version
+// reached the expected state
+func waitForCmVersion(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, versionLocID string, timeout time.Duration, done func(*catalogmanagementv1.Version) bool) (*catalogmanagementv1.Version, error) {
+	var version *catalogmanagementv1.Version
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inProgress},
+		Target:  []string{success},
+		Refresh: func() (interface{}, string, error) {
+			getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
+			getVersionOptions.SetVersionLocID(versionLocID)
+			offering, response, err := catalogManagementClient.GetVersion(getVersionOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving version (%s): %s\n%s", versionLocID, err, response)
+			}
+			if len(offering.Kinds) == 0 || len(offering.Kinds[0].Versions) == 0 {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving version (%s): no version returned", versionLocID)
+			}
+			version = &offering.Kinds[0].Versions[0]
+			if done(version) {
+				return version, success, nil
+			}
+			return version, inProgress, nil
+		},
+		Delay:      waitUntilInterval,
+		MinTimeout: waitUntilInterval,
+		Timeout:    timeout,
+	}
+	_, err := stateConf.WaitForState()
+	return version, err
+}
@@ -0,0 +1,259 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+// ResourceIBMCmAccount manages the catalog settings of the account, which
+// filter the offerings of the public catalog shown in the account
+func ResourceIBMCmAccount() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCmAccountCreate,
+		Read:     resourceIBMCmAccountRead,
+		Update:   resourceIBMCmAccountUpdate,
+		Delete:   resourceIBMCmAccountDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"hide_ibm_cloud_catalog": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hide the public catalog in this account.",
+			},
+			"include_all": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Include all of the public catalog when filtering, the filters then exclude some offerings. When false, the filters include some offerings.",
+			},
+			"category_filters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filters against offering properties.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The offering property to filter on, such as category or provider.",
+						},
+						"include": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the filter includes or excludes the matching offerings.",
+						},
+						"filter_terms": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of values to match against.",
+						},
+					},
+				},
+			},
+			"id_filters": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Filters on offering IDs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The offering IDs that are included.",
+						},
+						"exclude": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The offering IDs that are excluded.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMCmAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	// The catalog settings exist with the account, they are only updated
+	account, response, err := catalogManagementClient.GetCatalogAccount(&catalogmanagementv1.GetCatalogAccountOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetCatalogAccount failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error getting catalog account: %s\n%s", err, response)
+	}
+	d.SetId(*account.ID)
+
+	if err = updateCmAccount(catalogManagementClient, d.Id(), d.Get("hide_ibm_cloud_catalog").(bool), expandCmAccountFilters(d)); err != nil {
+		return err
+	}
+
+	return resourceIBMCmAccountRead(d, meta)
+}
+
+func resourceIBMCmAccountRead(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	account, response, err := catalogManagementClient.GetCatalogAccount(&catalogmanagementv1.GetCatalogAccountOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetCatalogAccount failed %s\n%s", err, response)
+		return err
+	}
+
+	if err = d.Set("hide_ibm_cloud_catalog", account.HideIBMCloudCatalog != nil && *account.HideIBMCloudCatalog); err != nil {
+		return fmt.Errorf("[ERROR] Error setting hide_ibm_cloud_catalog: %s", err)
+	}
+	filters := account.AccountFilters
+	if filters == nil {
+		filters = &catalogmanagementv1.Filters{}
+	}
+	if err = d.Set("include_all", filters.IncludeAll == nil || *filters.IncludeAll); err != nil {
+		return fmt.Errorf("[ERROR] Error setting include_all: %s", err)
+	}
+	if err = d.Set("category_filters", flattenCmCategoryFilters(filters.CategoryFilters)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting category_filters: %s", err)
+	}
+	if err = d.Set("id_filters", flattenCmIDFilters(filters.IDFilters)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting id_filters: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIBMCmAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	if err = updateCmAccount(catalogManagementClient, d.Id(), d.Get("hide_ibm_cloud_catalog").(bool), expandCmAccountFilters(d)); err != nil {
+		return err
+	}
+
+	return resourceIBMCmAccountRead(d, meta)
+}
+
+func resourceIBMCmAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	// The account goes back to the whole public catalog
+	filters := &catalogmanagementv1.Filters{IncludeAll: core.BoolPtr(true)}
+	if err = updateCmAccount(catalogManagementClient, d.Id(), false, filters); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func updateCmAccount(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, accountID string, hideIBMCloudCatalog bool, filters *catalogmanagementv1.Filters) error {
+	updateCatalogAccountOptions := &catalogmanagementv1.UpdateCatalogAccountOptions{}
+	updateCatalogAccountOptions.SetID(accountID)
+	updateCatalogAccountOptions.SetHideIBMCloudCatalog(hideIBMCloudCatalog)
+	updateCatalogAccountOptions.SetAccountFilters(filters)
+
+	response, err := catalogManagementClient.UpdateCatalogAccount(updateCatalogAccountOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateCatalogAccount failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error updating catalog account (%s): %s\n%s", accountID, err, response)
+	}
+	return nil
+}
+
+func expandCmAccountFilters(d *schema.ResourceData) *catalogmanagementv1.Filters {
+	filters := &catalogmanagementv1.Filters{
+		IncludeAll: core.BoolPtr(d.Get("include_all").(bool)),
+	}
+
+	categoryFilters := d.Get("category_filters").([]interface{})
+	if len(categoryFilters) > 0 {
+		filters.CategoryFilters = make(map[string]catalogmanagementv1.CategoryFilter, len(categoryFilters))
+		for _, v := range categoryFilters {
+			m := v.(map[string]interface{})
+			filters.CategoryFilters[m["category_name"].(string)] = catalogmanagementv1.CategoryFilter{
+				Include: core.BoolPtr(m["include"].(bool)),
+				Filter: &catalogmanagementv1.FilterTerms{
+					FilterTerms: flex.ExpandStringList(m["filter_terms"].([]interface{})),
+				},
+			}
+		}
+	}
+
+	if l := d.Get("id_filters").([]interface{}); len(l) > 0 && l[0] != nil {
+		m := l[0].(map[string]interface{})
+		filters.IDFilters = &catalogmanagementv1.IDFilter{
+			Include: &catalogmanagementv1.FilterTerms{FilterTerms: flex.ExpandStringList(m["include"].([]interface{}))},
+			Exclude: &catalogmanagementv1.FilterTerms{FilterTerms: flex.ExpandStringList(m["exclude"].([]interface{}))},
+		}
+	}
+
+	return filters
+}
+
+func flattenCmCategoryFilters(categoryFilters map[string]catalogmanagementv1.CategoryFilter) []interface{} {
+	// The filters are sorted by name, the API returns them as a map
+	names := make([]string, 0, len(categoryFilters))
+	for name := range categoryFilters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	result := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		categoryFilter := categoryFilters[name]
+		m := map[string]interface{}{
+			"category_name": name,
+			"include":       categoryFilter.Include != nil && *categoryFilter.Include,
+		}
+		if categoryFilter.Filter != nil {
+			m["filter_terms"] = categoryFilter.Filter.FilterTerms
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenCmIDFilters(idFilters *catalogmanagementv1.IDFilter) []interface{} {
+	if idFilters == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{}
+	if idFilters.Include != nil {
+		m["include"] = idFilters.Include.FilterTerms
+	}
+	if idFilters.Exclude != nil {
+		m["exclude"] = idFilters.Exclude.FilterTerms
+	}
+	if len(m) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{m}
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCmAccount(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCmAccountConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cm_account.cm_account", "include_all", "true"),
+					resource.TestCheckResourceAttr("ibm_cm_account.cm_account", "category_filters.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cm_account.cm_account", "category_filters.0.category_name", "category"),
+					resource.TestCheckResourceAttr("ibm_cm_account.cm_account", "category_filters.0.include", "false"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cm_account.cm_account",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCmAccountConfig() string {
+	return `
+
+		resource "ibm_cm_account" "cm_account" {
+			include_all = true
+			category_filters {
+				category_name = "category"
+				include = false
+				filter_terms = ["blockchain"]
+			}
+		}
+		`
+}
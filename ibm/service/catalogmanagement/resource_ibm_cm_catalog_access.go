@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+// ResourceIBMCmCatalogAccess shares a catalog, or one of its objects, with
+// accounts and enterprises
+func ResourceIBMCmCatalogAccess() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCmCatalogAccessCreate,
+		Read:     resourceIBMCmCatalogAccessRead,
+		Update:   resourceIBMCmCatalogAccessUpdate,
+		Delete:   resourceIBMCmCatalogAccessDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Catalog identifier.",
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Object identifier, shares the object instead of the whole catalog.",
+			},
+			"accounts": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The accounts that have access.",
+			},
+			"enterprises": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The enterprises that have access, with all their accounts.",
+			},
+		},
+	}
+}
+
+func resourceIBMCmCatalogAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+	objectID := d.Get("object_id").(string)
+	accesses := expandCmAccesses(d.Get("accounts").(*schema.Set).List(), d.Get("enterprises").(*schema.Set).List())
+	if err = changeCmAccessList(catalogManagementClient, catalogID, objectID, core.POST, accesses); err != nil {
+		return err
+	}
+
+	if objectID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", catalogID, objectID))
+	} else {
+		d.SetId(catalogID)
+	}
+
+	return resourceIBMCmCatalogAccessRead(d, meta)
+}
+
+func resourceIBMCmCatalogAccessRead(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(d.Id(), "/", 2)
+	catalogID, objectID := parts[0], ""
+	if len(parts) > 1 {
+		objectID = parts[1]
+	}
+
+	accesses, response, err := listCmAccessList(catalogManagementClient, catalogID, objectID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	accounts := []string{}
+	enterprises := []string{}
+	for _, access := range accesses {
+		if strings.HasPrefix(access, cmEnterpriseAccessPrefix) {
+			enterprises = append(enterprises, strings.TrimPrefix(access, cmEnterpriseAccessPrefix))
+		} else {
+			accounts = append(accounts, access)
+		}
+	}
+
+	if err = d.Set("catalog_id", catalogID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting catalog_id: %s", err)
+	}
+	if err = d.Set("object_id", objectID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting object_id: %s", err)
+	}
+	if err = d.Set("accounts", flex.NewStringSet(schema.HashString, accounts)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting accounts: %s", err)
+	}
+	if err = d.Set("enterprises", flex.NewStringSet(schema.HashString, enterprises)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting enterprises: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIBMCmCatalogAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+	objectID := d.Get("object_id").(string)
+
+	oldAccounts, newAccounts := d.GetChange("accounts")
+	oldEnterprises, newEnterprises := d.GetChange("enterprises")
+	removed := expandCmAccesses(
+		oldAccounts.(*schema.Set).Difference(newAccounts.(*schema.Set)).List(),
+		oldEnterprises.(*schema.Set).Difference(newEnterprises.(*schema.Set)).List())
+	added := expandCmAccesses(
+		newAccounts.(*schema.Set).Difference(oldAccounts.(*schema.Set)).List(),
+		newEnterprises.(*schema.Set).Difference(oldEnterprises.(*schema.Set)).List())
+
+	if err = changeCmAccessList(catalogManagementClient, catalogID, objectID, core.DELETE, removed); err != nil {
+		return err
+	}
+	if err = changeCmAccessList(catalogManagementClient, catalogID, objectID, core.POST, added); err != nil {
+		return err
+	}
+
+	return resourceIBMCmCatalogAccessRead(d, meta)
+}
+
+func resourceIBMCmCatalogAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	accesses := expandCmAccesses(d.Get("accounts").(*schema.Set).List(), d.Get("enterprises").(*schema.Set).List())
+	if err = changeCmAccessList(catalogManagementClient, d.Get("catalog_id").(string), d.Get("object_id").(string), core.DELETE, accesses); err != nil {
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// expandCmAccesses returns the access list entries of the accounts and the
+// enterprises, the enterprises are prefixed
+func expandCmAccesses(accounts, enterprises []interface{}) []string {
+	accesses := flex.ExpandStringList(accounts)
+	for _, enterpriseID := range flex.ExpandStringList(enterprises) {
+		accesses = append(accesses, cmEnterpriseAccess(enterpriseID))
+	}
+	return accesses
+}
+
+// changeCmAccessList adds (POST) or removes (DELETE) the accesses to the
+// catalog, or to the object when objectID is set
+func changeCmAccessList(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, catalogID, objectID, method string, accesses []string) error {
+	if len(accesses) == 0 {
+		return nil
+	}
+
+	var result *catalogmanagementv1.AccessListBulkResponse
+	var response *core.DetailedResponse
+	var err error
+	switch {
+	case objectID != "" && method == core.POST:
+		result, response, err = catalogManagementClient.AddObjectAccessList(catalogManagementClient.NewAddObjectAccessListOptions(catalogID, objectID, accesses))
+	case objectID != "":
+		result, response, err = catalogManagementClient.DeleteObjectAccessList(catalogManagementClient.NewDeleteObjectAccessListOptions(catalogID, objectID, accesses))
+	default:
+		result = &catalogmanagementv1.AccessListBulkResponse{}
+		response, err = cmRequest(catalogManagementClient, method, "/catalogs/{catalog_identifier}/accessList", map[string]string{"catalog_identifier": catalogID}, accesses, result)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] Changing access list failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error changing access list of catalog (%s): %s\n%s", catalogID, err, response)
+	}
+	if result != nil && len(result.Errors) > 0 {
+		return fmt.Errorf("[ERROR] Error changing access list of catalog (%s): %v", catalogID, result.Errors)
+	}
+	return nil
+}
+
+// listCmAccessList returns all the access list entries of the catalog, or of
+// the object when objectID is set
+func listCmAccessList(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, catalogID, objectID string) ([]string, *core.DetailedResponse, error) {
+	accesses := []string{}
+	var offset int64
+	for {
+		var result *catalogmanagementv1.ObjectAccessListResult
+		var response *core.DetailedResponse
+		var err error
+		if objectID != "" {
+			getObjectAccessListOptions := catalogManagementClient.NewGetObjectAccessListOptions(catalogID, objectID)
+			getObjectAccessListOptions.SetOffset(offset)
+			result, response, err = catalogManagementClient.GetObjectAccessList(getObjectAccessListOptions)
+		} else {
+			result = &catalogmanagementv1.ObjectAccessListResult{}
+			path := fmt.Sprintf("/catalogs/{catalog_identifier}/accessList?offset=%d", offset)
+			response, err = cmRequest(catalogManagementClient, core.GET, path, map[string]string{"catalog_identifier": catalogID}, nil, result)
+		}
+		if err != nil {
+			log.Printf("[DEBUG] Getting access list failed %s\n%s", err, response)
+			return nil, response, fmt.Errorf("[ERROR] Error getting access list of catalog (%s): %s\n%s", catalogID, err, response)
+		}
+		for _, access := range result.Resources {
+			if access.Account != nil {
+				accesses = append(accesses, *access.Account)
+			}
+		}
+		offset += int64(len(result.Resources))
+		if len(result.Resources) == 0 || result.TotalCount == nil || offset >= *result.TotalCount {
+			return accesses, response, nil
+		}
+	}
+}
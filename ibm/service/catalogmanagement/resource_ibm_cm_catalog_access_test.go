@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCmCatalogAccess(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCmCatalogAccessConfig(acc.CmShareAccountID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cm_catalog_access.cm_catalog_access", "accounts.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cm_catalog_access.cm_catalog_access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCmCatalogAccessConfig(accountID string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cm_catalog" "cm_catalog" {
+			label = "tf_test_access_catalog"
+			short_description = "testing terraform provider with catalog"
+		}
+
+		resource "ibm_cm_catalog_access" "cm_catalog_access" {
+			catalog_id = ibm_cm_catalog.cm_catalog.id
+			accounts = ["%s"]
+		}
+		`, accountID)
+}
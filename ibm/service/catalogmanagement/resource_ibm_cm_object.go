@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ResourceIBMCmObject manages a catalog object, an artefact of a catalog
+// that isn't an offering, such as a VPE or a preset configuration
+func ResourceIBMCmObject() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCmObjectCreate,
+		Read:     resourceIBMCmObjectRead,
+		Update:   resourceIBMCmObjectUpdate,
+		Delete:   resourceIBMCmObjectDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Catalog identifier.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The programmatic name of this object.",
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Kind of object, such as vpe or preset_configuration.",
+			},
+			"parent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The parent for this specific object, such as a region.",
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Display name in the requested language.",
+			},
+			"short_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Short description in the requested language.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of tags associated with this object.",
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "Map of data values for this object, in JSON.",
+			},
+			"object_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The object identifier.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The crn for this specific object.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The url for this specific object.",
+			},
+			"catalog_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the catalog.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current state of the object.",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time this object was created.",
+			},
+			"updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time this object was last updated.",
+			},
+		},
+	}
+}
+
+func resourceIBMCmObjectCreate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+	createObjectOptions := catalogManagementClient.NewCreateObjectOptions(catalogID)
+	createObjectOptions.SetCatalogID(catalogID)
+	createObjectOptions.SetName(d.Get("name").(string))
+	createObjectOptions.SetKind(d.Get("kind").(string))
+	if _, ok := d.GetOk("parent_id"); ok {
+		createObjectOptions.SetParentID(d.Get("parent_id").(string))
+	}
+	if _, ok := d.GetOk("label"); ok {
+		createObjectOptions.SetLabel(d.Get("label").(string))
+	}
+	if _, ok := d.GetOk("short_description"); ok {
+		createObjectOptions.SetShortDescription(d.Get("short_description").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		createObjectOptions.SetTags(flex.ExpandStringList(d.Get("tags").([]interface{})))
+	}
+	data, err := expandCmObjectData(d)
+	if err != nil {
+		return err
+	}
+	createObjectOptions.SetData(data)
+
+	object, response, err := catalogManagementClient.CreateObject(createObjectOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateObject failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error creating catalog object: %s\n%s", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", catalogID, *object.ID))
+
+	return resourceIBMCmObjectRead(d, meta)
+}
+
+func resourceIBMCmObjectRead(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of catalogID/objectID", d.Id())
+	}
+
+	getObjectOptions := catalogManagementClient.NewGetObjectOptions(parts[0], parts[1])
+	object, response, err := catalogManagementClient.GetObject(getObjectOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetObject failed %s\n%s", err, response)
+		return err
+	}
+
+	if err = d.Set("catalog_id", parts[0]); err != nil {
+		return fmt.Errorf("[ERROR] Error setting catalog_id: %s", err)
+	}
+	if err = d.Set("object_id", object.ID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting object_id: %s", err)
+	}
+	if err = d.Set("name", object.Name); err != nil {
+		return fmt.Errorf("[ERROR] Error setting name: %s", err)
+	}
+	if err = d.Set("kind", object.Kind); err != nil {
+		return fmt.Errorf("[ERROR] Error setting kind: %s", err)
+	}
+	if err = d.Set("parent_id", object.ParentID); err != nil {
+		return fmt.Errorf("[ERROR] Error setting parent_id: %s", err)
+	}
+	if err = d.Set("label", object.Label); err != nil {
+		return fmt.Errorf("[ERROR] Error setting label: %s", err)
+	}
+	if err = d.Set("short_description", object.ShortDescription); err != nil {
+		return fmt.Errorf("[ERROR] Error setting short_description: %s", err)
+	}
+	if object.Tags != nil {
+		if err = d.Set("tags", object.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	if object.Data != nil {
+		data, err := json.Marshal(object.Data)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error encoding data: %s", err)
+		}
+		if err = d.Set("data", string(data)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting data: %s", err)
+		}
+	}
+	if err = d.Set("crn", object.CRN); err != nil {
+		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
+	}
+	if err = d.Set("url", object.URL); err != nil {
+		return fmt.Errorf("[ERROR] Error setting url: %s", err)
+	}
+	if err = d.Set("catalog_name", object.CatalogName); err != nil {
+		return fmt.Errorf("[ERROR] Error setting catalog_name: %s", err)
+	}
+	if object.State != nil {
+		if err = d.Set("state", object.State.Current); err != nil {
+			return fmt.Errorf("[ERROR] Error setting state: %s", err)
+		}
+	}
+	if object.Created != nil {
+		if err = d.Set("created", object.Created.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created: %s", err)
+		}
+	}
+	if object.Updated != nil {
+		if err = d.Set("updated", object.Updated.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCmObjectUpdate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// The object is replaced as a whole, with the revision it was read at
+	getObjectOptions := catalogManagementClient.NewGetObjectOptions(parts[0], parts[1])
+	object, response, err := catalogManagementClient.GetObject(getObjectOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetObject failed %s\n%s", err, response)
+		return err
+	}
+
+	replaceObjectOptions := catalogManagementClient.NewReplaceObjectOptions(parts[0], parts[1])
+	replaceObjectOptions.SetID(*object.ID)
+	replaceObjectOptions.SetRev(*object.Rev)
+	replaceObjectOptions.SetCatalogID(parts[0])
+	replaceObjectOptions.SetName(d.Get("name").(string))
+	replaceObjectOptions.SetKind(d.Get("kind").(string))
+	if _, ok := d.GetOk("parent_id"); ok {
+		replaceObjectOptions.SetParentID(d.Get("parent_id").(string))
+	}
+	replaceObjectOptions.SetLabel(d.Get("label").(string))
+	replaceObjectOptions.SetShortDescription(d.Get("short_description").(string))
+	replaceObjectOptions.SetTags(flex.ExpandStringList(d.Get("tags").([]interface{})))
+	data, err := expandCmObjectData(d)
+	if err != nil {
+		return err
+	}
+	replaceObjectOptions.SetData(data)
+
+	_, response, err = catalogManagementClient.ReplaceObject(replaceObjectOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ReplaceObject failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error updating catalog object (%s): %s\n%s", parts[1], err, response)
+	}
+
+	return resourceIBMCmObjectRead(d, meta)
+}
+
+func resourceIBMCmObjectDelete(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+
+	deleteObjectOptions := catalogManagementClient.NewDeleteObjectOptions(parts[0], parts[1])
+	response, err := catalogManagementClient.DeleteObject(deleteObjectOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteObject failed %s\n%s", err, response)
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCmObjectData(d *schema.ResourceData) (map[string]interface{}, error) {
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("data"); ok {
+		if err := json.Unmarshal([]byte(v.(string)), &data); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error decoding data: %s", err)
+		}
+	}
+	return data, nil
+}
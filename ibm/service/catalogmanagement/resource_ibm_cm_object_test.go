@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCmObject(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCmObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCmObjectConfig("tf_test_object"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCmObjectExists("ibm_cm_object.cm_object"),
+					resource.TestCheckResourceAttr("ibm_cm_object.cm_object", "label", "tf_test_object"),
+					resource.TestCheckResourceAttrSet("ibm_cm_object.cm_object", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCmObjectConfig("tf_test_object_updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCmObjectExists("ibm_cm_object.cm_object"),
+					resource.TestCheckResourceAttr("ibm_cm_object.cm_object", "label", "tf_test_object_updated"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cm_object.cm_object",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCmObjectConfig(label string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cm_catalog" "cm_catalog" {
+			label = "tf_test_object_catalog"
+			short_description = "testing terraform provider with catalog"
+			kind = "vpe"
+		}
+
+		resource "ibm_cm_object" "cm_object" {
+			catalog_id = ibm_cm_catalog.cm_catalog.id
+			name = "tf_test_object"
+			kind = "vpe"
+			parent_id = "us-south"
+			label = "%s"
+			short_description = "testing terraform provider with catalog object"
+			tags = ["dev_ops"]
+			data = jsonencode({
+				endpoint = "https://example.com"
+			})
+		}
+		`, label)
+}
+
+func testAccCheckIBMCmObjectExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		catalogManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CatalogManagementV1()
+		if err != nil {
+			return err
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		getObjectOptions := catalogManagementClient.NewGetObjectOptions(parts[0], parts[1])
+		_, _, err = catalogManagementClient.GetObject(getObjectOptions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMCmObjectDestroy(s *terraform.State) error {
+	catalogManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cm_object" {
+			continue
+		}
+
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		getObjectOptions := catalogManagementClient.NewGetObjectOptions(parts[0], parts[1])
+		_, response, err := catalogManagementClient.GetObject(getObjectOptions)
+		if err == nil {
+			return fmt.Errorf("cm_object still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 && response.StatusCode != 403 {
+			return fmt.Errorf("[ERROR] Error checking for cm_object (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
 )
 
@@ -18,6 +19,7 @@ func ResourceIBMCmOffering() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMCmOfferingCreate,
 		Read:     resourceIBMCmOfferingRead,
+		Update:   resourceIBMCmOfferingUpdate,
 		Delete:   resourceIBMCmOfferingDelete,
 		Importer: &schema.ResourceImporter{},
 
@@ -136,6 +138,64 @@ func ResourceIBMCmOffering() *schema.Resource {
 				Computed:    true,
 				Description: "Determine if this offering should be displayed in the Consumption UI.",
 			},
+			"features": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of features associated with this offering.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Heading.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Feature description.",
+						},
+					},
+				},
+			},
+			"badges": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "A list of badges for this offering.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the current badge.",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Display name for the current badge.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the current badge.",
+						},
+						"icon": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Icon for the current badge.",
+						},
+						"authority": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Authority for the current badge.",
+						},
+						"tag": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Tag for the current badge.",
+						},
+					},
+				},
+			},
 			"repo_info": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -193,6 +253,14 @@ func resourceIBMCmOfferingCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*offering.ID)
 
+	_, hasFeatures := d.GetOk("features")
+	_, hasBadges := d.GetOk("badges")
+	if hasFeatures || hasBadges {
+		if err = updateCmOfferingFeaturesAndBadges(d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMCmOfferingRead(d, meta)
 }
 
@@ -276,6 +344,38 @@ func resourceIBMCmOfferingRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("hidden", offering.Hidden); err != nil {
 		return fmt.Errorf("[ERROR] Error setting hidden: %s", err)
 	}
+	if offering.Features != nil {
+		features := []map[string]interface{}{}
+		for _, feature := range offering.Features {
+			features = append(features, map[string]interface{}{
+				"title":       feature.Title,
+				"description": feature.Description,
+			})
+		}
+		if err = d.Set("features", features); err != nil {
+			return fmt.Errorf("[ERROR] Error setting features: %s", err)
+		}
+	}
+	badges, err := getCmOfferingBadges(catalogManagementClient, d.Get("catalog_id").(string), d.Id())
+	if err != nil {
+		return err
+	}
+	if badges != nil {
+		badgeList := []map[string]interface{}{}
+		for _, badge := range badges {
+			badgeList = append(badgeList, map[string]interface{}{
+				"id":          badge.ID,
+				"label":       badge.Label,
+				"description": badge.Description,
+				"icon":        badge.Icon,
+				"authority":   badge.Authority,
+				"tag":         badge.Tag,
+			})
+		}
+		if err = d.Set("badges", badgeList); err != nil {
+			return fmt.Errorf("[ERROR] Error setting badges: %s", err)
+		}
+	}
 	if offering.RepoInfo != nil {
 		repoInfoMap := resourceIBMCmOfferingRepoInfoToMap(*offering.RepoInfo)
 		if err = d.Set("repo_info", []map[string]interface{}{repoInfoMap}); err != nil {
@@ -295,6 +395,67 @@ func resourceIBMCmOfferingRepoInfoToMap(repoInfo catalogmanagementv1.RepoInfo) m
 	return repoInfoMap
 }
 
+func resourceIBMCmOfferingUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("features") || d.HasChange("badges") {
+		if err := updateCmOfferingFeaturesAndBadges(d, meta); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMCmOfferingRead(d, meta)
+}
+
+// updateCmOfferingFeaturesAndBadges replaces the features and badges of the
+// offering with a JSON patch
+func updateCmOfferingFeaturesAndBadges(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	getOfferingOptions := &catalogmanagementv1.GetOfferingOptions{}
+	getOfferingOptions.SetCatalogIdentifier(d.Get("catalog_id").(string))
+	getOfferingOptions.SetOfferingID(d.Id())
+	_, response, err := catalogManagementClient.GetOffering(getOfferingOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetOffering failed %s\n%s", err, response)
+		return err
+	}
+
+	features := []catalogmanagementv1.Feature{}
+	for _, item := range d.Get("features").([]interface{}) {
+		m := item.(map[string]interface{})
+		features = append(features, catalogmanagementv1.Feature{
+			Title:       core.StringPtr(m["title"].(string)),
+			Description: core.StringPtr(m["description"].(string)),
+		})
+	}
+	badges := []cmBadge{}
+	for _, item := range d.Get("badges").([]interface{}) {
+		m := item.(map[string]interface{})
+		badges = append(badges, cmBadge{
+			ID:          cmOptionalString(m, "id"),
+			Label:       cmOptionalString(m, "label"),
+			Description: cmOptionalString(m, "description"),
+			Icon:        cmOptionalString(m, "icon"),
+			Authority:   cmOptionalString(m, "authority"),
+			Tag:         cmOptionalString(m, "tag"),
+		})
+	}
+
+	updateOfferingOptions := catalogManagementClient.NewUpdateOfferingOptions(d.Get("catalog_id").(string), d.Id(), response.Headers.Get("Etag"))
+	updateOfferingOptions.SetUpdates([]catalogmanagementv1.JSONPatchOperation{
+		{Op: core.StringPtr(catalogmanagementv1.JSONPatchOperationOpAddConst), Path: core.StringPtr("/features"), Value: features},
+		{Op: core.StringPtr(catalogmanagementv1.JSONPatchOperationOpAddConst), Path: core.StringPtr("/badges"), Value: badges},
+	})
+	_, response, err = catalogManagementClient.UpdateOffering(updateOfferingOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateOffering failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error updating features and badges of offering (%s): %s\n%s", d.Id(), err, response)
+	}
+	return nil
+}
+
 func resourceIBMCmOfferingDelete(d *schema.ResourceData, meta interface{}) error {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+const (
+	cmPublishTargetAccount    = "account"
+	cmPublishTargetIBM        = "ibm"
+	cmPublishTargetPublic     = "public"
+	cmPublishTargetEnterprise = "enterprise"
+)
+
+// ResourceIBMCmOfferingPublish publishes a version of an offering to the
+// account, the enterprise, all IBMers or the public catalog
+func ResourceIBMCmOfferingPublish() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCmOfferingPublishCreate,
+		Read:   resourceIBMCmOfferingPublishRead,
+		Delete: resourceIBMCmOfferingPublishDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Catalog identifier.",
+			},
+			"offering_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Offering identification.",
+			},
+			"version_loc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A dotted value of `catalogID`.`versionID`.",
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_cm_offering_publish", "target"),
+				Description:  "Where the version is published - account, enterprise, ibm or public.",
+			},
+			"enterprise_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The enterprise the offering is shared with, required when target is enterprise.",
+			},
+			"wait_for_approval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Waits for the approval of the offering when publishing to ibm or public.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current state of the version.",
+			},
+			"ibm_publish_approved": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if this offering has been approved for use by all IBMers.",
+			},
+			"public_publish_approved": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if this offering has been approved for use by all IBM Cloud users.",
+			},
+		},
+	}
+}
+
+func ResourceIBMCmOfferingPublishValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "target",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "account, enterprise, ibm, public",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cm_offering_publish", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCmOfferingPublishCreate(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	catalogID := d.Get("catalog_id").(string)
+	offeringID := d.Get("offering_id").(string)
+	versionLocID := d.Get("version_loc_id").(string)
+	target := d.Get("target").(string)
+	enterpriseID := d.Get("enterprise_id").(string)
+	if target == cmPublishTargetEnterprise && enterpriseID == "" {
+		return fmt.Errorf("[ERROR] enterprise_id is required to publish to the enterprise")
+	}
+	timeout := d.Timeout(schema.TimeoutCreate)
+
+	switch target {
+	case cmPublishTargetAccount, cmPublishTargetEnterprise:
+		if err = publishCmVersionToAccount(catalogManagementClient, versionLocID, timeout); err != nil {
+			return err
+		}
+	case cmPublishTargetIBM:
+		response, err := catalogManagementClient.IBMPublishVersion(catalogManagementClient.NewIBMPublishVersionOptions(versionLocID))
+		if err != nil {
+			log.Printf("[DEBUG] IBMPublishVersion failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error publishing version (%s) to IBM: %s\n%s", versionLocID, err, response)
+		}
+	case cmPublishTargetPublic:
+		response, err := catalogManagementClient.PublicPublishVersion(catalogManagementClient.NewPublicPublishVersionOptions(versionLocID))
+		if err != nil {
+			log.Printf("[DEBUG] PublicPublishVersion failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error publishing version (%s) to public: %s\n%s", versionLocID, err, response)
+		}
+	}
+
+	if target == cmPublishTargetEnterprise {
+		pathParams := map[string]string{"catalog_identifier": catalogID, "offering_id": offeringID}
+		shareBody := map[string]interface{}{"enabled": true}
+		response, err := cmRequest(catalogManagementClient, core.POST, "/catalogs/{catalog_identifier}/offerings/{offering_id}/share", pathParams, shareBody, nil)
+		if err != nil {
+			log.Printf("[DEBUG] ShareOffering failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error sharing offering (%s): %s\n%s", offeringID, err, response)
+		}
+		response, err = cmRequest(catalogManagementClient, core.POST, "/catalogs/{catalog_identifier}/offerings/{offering_id}/accessList", pathParams, []string{cmEnterpriseAccess(enterpriseID)}, nil)
+		if err != nil {
+			log.Printf("[DEBUG] AddOfferingAccessList failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error giving enterprise (%s) access to offering (%s): %s\n%s", enterpriseID, offeringID, err, response)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", versionLocID, target))
+
+	if (target == cmPublishTargetIBM || target == cmPublishTargetPublic) && d.Get("wait_for_approval").(bool) {
+		if err = waitForCmOfferingApproval(catalogManagementClient, catalogID, offeringID, target, timeout); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMCmOfferingPublishRead(d, meta)
+}
+
+func resourceIBMCmOfferingPublishRead(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	getVersionOptions := &catalogmanagementv1.GetVersionOptions{}
+	getVersionOptions.SetVersionLocID(d.Get("version_loc_id").(string))
+	offering, response, err := catalogManagementClient.GetVersion(getVersionOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetVersion failed %s\n%s", err, response)
+		return err
+	}
+
+	if len(offering.Kinds) > 0 && len(offering.Kinds[0].Versions) > 0 && offering.Kinds[0].Versions[0].State != nil {
+		if err = d.Set("state", offering.Kinds[0].Versions[0].State.Current); err != nil {
+			return fmt.Errorf("[ERROR] Error setting state: %s", err)
+		}
+	}
+	if err = d.Set("ibm_publish_approved", offering.IBMPublishApproved != nil && *offering.IBMPublishApproved); err != nil {
+		return fmt.Errorf("[ERROR] Error setting ibm_publish_approved: %s", err)
+	}
+	if err = d.Set("public_publish_approved", offering.PublicPublishApproved != nil && *offering.PublicPublishApproved); err != nil {
+		return fmt.Errorf("[ERROR] Error setting public_publish_approved: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIBMCmOfferingPublishDelete(d *schema.ResourceData, meta interface{}) error {
+	// Only the enterprise access is taken back, a published version stays
+	// published until it is deprecated
+	if d.Get("target").(string) == cmPublishTargetEnterprise {
+		catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+		if err != nil {
+			return err
+		}
+
+		offeringID := d.Get("offering_id").(string)
+		pathParams := map[string]string{"catalog_identifier": d.Get("catalog_id").(string), "offering_id": offeringID}
+		response, err := cmRequest(catalogManagementClient, core.DELETE, "/catalogs/{catalog_identifier}/offerings/{offering_id}/accessList", pathParams, []string{cmEnterpriseAccess(d.Get("enterprise_id").(string))}, nil)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteOfferingAccessList failed %s\n%s", err, response)
+			return fmt.Errorf("[ERROR] Error removing enterprise access to offering (%s): %s\n%s", offeringID, err, response)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// publishCmVersionToAccount publishes the version to the account and waits
+// for the version to be published
+func publishCmVersionToAccount(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, versionLocID string, timeout time.Duration) error {
+	response, err := catalogManagementClient.AccountPublishVersion(catalogManagementClient.NewAccountPublishVersionOptions(versionLocID))
+	if err != nil {
+		log.Printf("[DEBUG] AccountPublishVersion failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error publishing version (%s) to the account: %s\n%s", versionLocID, err, response)
+	}
+
+	_, err = waitForCmVersion(catalogManagementClient, versionLocID, timeout, func(version *catalogmanagementv1.Version) bool {
+		return version.State != nil && version.State.Current != nil && *version.State.Current == cmVersionStatePublished
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for version (%s) to be published: %s", versionLocID, err)
+	}
+	return nil
+}
+
+// waitForCmOfferingApproval polls the offering until its publication to
+// IBM or public is approved
+func waitForCmOfferingApproval(catalogManagementClient *catalogmanagementv1.CatalogManagementV1, catalogID, offeringID, target string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inProgress},
+		Target:  []string{success},
+		Refresh: func() (interface{}, string, error) {
+			getOfferingOptions := catalogManagementClient.NewGetOfferingOptions(catalogID, offeringID)
+			offering, response, err := catalogManagementClient.GetOffering(getOfferingOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving offering (%s): %s\n%s", offeringID, err, response)
+			}
+			approved := offering.IBMPublishApproved
+			if target == cmPublishTargetPublic {
+				approved = offering.PublicPublishApproved
+			}
+			if approved != nil && *approved {
+				return offering, success, nil
+			}
+			return offering, inProgress, nil
+		},
+		Delay:      waitUntilInterval,
+		MinTimeout: waitUntilInterval,
+		Timeout:    timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for approval of offering (%s) for %s: %s", offeringID, target, err)
+	}
+	return nil
+}
@@ -6,6 +6,8 @@ package catalogmanagement
 import (
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -18,9 +20,15 @@ func ResourceIBMCmVersion() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMCmVersionCreate,
 		Read:     resourceIBMCmVersionRead,
+		Update:   resourceIBMCmVersionUpdate,
 		Delete:   resourceIBMCmVersionDelete,
 		Importer: &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"catalog_identifier": {
 				Type:        schema.TypeString,
@@ -66,6 +74,32 @@ func ResourceIBMCmVersion() *schema.Resource {
 				ForceNew:    true,
 				Description: "The semver value for this new version, if not found in the zip url package content.",
 			},
+			"deprecate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Deprecates the version, or restores a deprecated version when set to false.",
+			},
+			"deprecate_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The reason of the deprecation, shown to the users of the version.",
+			},
+			"days_until_deprecate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of days before the version is deprecated, the version is deprecated right away when not set.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The current state of the version.",
+			},
+			"pending_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state the version is moving to, such as a scheduled deprecation.",
+			},
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -157,6 +191,12 @@ func resourceIBMCmVersionCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(versionLocator)
 
+	if d.Get("deprecate").(bool) {
+		if err = setCmVersionDeprecation(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMCmVersionRead(d, meta)
 }
 
@@ -171,8 +211,6 @@ func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
 	getVersionOptions.SetVersionLocID(d.Id())
 
 	offering, response, err := catalogManagementClient.GetVersion(getVersionOptions)
-	version := offering.Kinds[0].Versions[0]
-
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -181,6 +219,7 @@ func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] GetVersion failed %s\n%s", err, response)
 		return err
 	}
+	version := offering.Kinds[0].Versions[0]
 
 	if err = d.Set("crn", version.CRN); err != nil {
 		return fmt.Errorf("[ERROR] Error setting crn: %s", err)
@@ -212,10 +251,75 @@ func resourceIBMCmVersionRead(d *schema.ResourceData, meta interface{}) error {
 	if err = d.Set("tgz_url", version.TgzURL); err != nil {
 		return fmt.Errorf("[ERROR] Error setting tgz_url: %s", err)
 	}
+	deprecated := version.Deprecated != nil && *version.Deprecated
+	// A scheduled deprecation is only reported as pending until the days run
+	// out, keep the configured value so the plan stays empty in the meantime
+	if !deprecated && d.Get("deprecate").(bool) && d.Get("days_until_deprecate").(int) > 0 &&
+		version.State != nil && version.State.Pending != nil && *version.State.Pending != "" {
+		deprecated = true
+	}
+	if err = d.Set("deprecate", deprecated); err != nil {
+		return fmt.Errorf("[ERROR] Error setting deprecate: %s", err)
+	}
+	if version.State != nil {
+		if err = d.Set("state", version.State.Current); err != nil {
+			return fmt.Errorf("[ERROR] Error setting state: %s", err)
+		}
+		if err = d.Set("pending_state", version.State.Pending); err != nil {
+			return fmt.Errorf("[ERROR] Error setting pending_state: %s", err)
+		}
+	}
 
 	return nil
 }
 
+func resourceIBMCmVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("deprecate") || d.HasChange("deprecate_description") || d.HasChange("days_until_deprecate") {
+		if err := setCmVersionDeprecation(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMCmVersionRead(d, meta)
+}
+
+// setCmVersionDeprecation deprecates or restores the version, and waits for
+// the version to be deprecated unless the deprecation is scheduled
+func setCmVersionDeprecation(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+
+	deprecate := d.Get("deprecate").(bool)
+	setDeprecateVersionOptions := catalogManagementClient.NewSetDeprecateVersionOptions(d.Id(), strconv.FormatBool(deprecate))
+	if _, ok := d.GetOk("deprecate_description"); ok {
+		setDeprecateVersionOptions.SetDescription(d.Get("deprecate_description").(string))
+	}
+	days := d.Get("days_until_deprecate").(int)
+	if deprecate && days > 0 {
+		setDeprecateVersionOptions.SetDaysUntilDeprecate(int64(days))
+	}
+
+	response, err := catalogManagementClient.SetDeprecateVersion(setDeprecateVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] SetDeprecateVersion failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error setting deprecation of version (%s): %s\n%s", d.Id(), err, response)
+	}
+	if deprecate && days > 0 {
+		log.Printf("[INFO] Version (%s) is deprecated in %d days", d.Id(), days)
+		return nil
+	}
+
+	_, err = waitForCmVersion(catalogManagementClient, d.Id(), timeout, func(version *catalogmanagementv1.Version) bool {
+		return (version.Deprecated != nil && *version.Deprecated) == deprecate
+	})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for deprecation of version (%s) to be %t: %s", d.Id(), deprecate, err)
+	}
+	return nil
+}
+
 func resourceIBMCmVersionDelete(d *schema.ResourceData, meta interface{}) error {
 	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
 	if err != nil {
@@ -30,6 +30,13 @@ func TestAccIBMCmVersion(t *testing.T) {
 					resource.TestCheckResourceAttrSet("ibm_cm_version.cm_version", "repo_url"),
 				),
 			},
+			{
+				Config: testAccCheckIBMCmVersionDeprecateConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCmVersionExists("ibm_cm_version.cm_version"),
+					resource.TestCheckResourceAttr("ibm_cm_version.cm_version", "deprecate", "true"),
+				),
+			},
 		},
 	})
 }
@@ -56,6 +63,30 @@ func testAccCheckIBMCmVersionConfig() string {
 		`
 }
 
+func testAccCheckIBMCmVersionDeprecateConfig() string {
+	return `
+
+		resource "ibm_cm_catalog" "cm_catalog" {
+			label = "tf_test_version_catalog"
+			short_description = "testing terraform provider with catalog"
+		}
+
+		resource "ibm_cm_offering" "cm_offering" {
+			catalog_id = ibm_cm_catalog.cm_catalog.id
+			label = "tf_test_offering"
+			tags = ["dev_ops", "target_roks", "operator"]
+		}
+
+		resource "ibm_cm_version" "cm_version" {
+			catalog_identifier = ibm_cm_catalog.cm_catalog.id
+			offering_id = ibm_cm_offering.cm_offering.id
+			zipurl = "https://raw.githubusercontent.com/operator-framework/community-operators/master/community-operators/cockroachdb/5.0.3/manifests/cockroachdb.clusterserviceversion.yaml"
+			deprecate = true
+			deprecate_description = "replaced by a newer version"
+		}
+		`
+}
+
 func testAccCheckIBMCmVersionExists(n string) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
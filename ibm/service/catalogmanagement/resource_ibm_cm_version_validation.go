@@ -0,0 +1,298 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+)
+
+// ResourceIBMCmVersionValidation validates a version by installing it on a
+// target, and validates it again whenever its triggers change
+func ResourceIBMCmVersionValidation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMCmVersionValidationCreate,
+		Read:   resourceIBMCmVersionValidationRead,
+		Update: resourceIBMCmVersionValidationUpdate,
+		Delete: resourceIBMCmVersionValidationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"version_loc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A dotted value of `catalogID`.`versionID`.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cluster ID, for the versions installed on a cluster.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Cluster region.",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Kube namespace.",
+			},
+			"override_values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values that override the default configuration of the version, such as the Terraform variables.",
+			},
+			"entitlement_apikey": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Entitlement API Key for this offering.",
+			},
+			"schematics": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The Schematics workspace that validates a Terraform version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Schematics workspace name.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Schematics workspace description.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Schematics workspace tags.",
+						},
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource group to use when creating the schematics workspace.",
+						},
+					},
+				},
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that validate the version again when they change.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current validation state - <empty>, in_progress, valid, invalid, expired.",
+			},
+			"validated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of last successful validation.",
+			},
+			"requested": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time of last validation was requested.",
+			},
+			"last_operation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last operation (e.g. submit_deployment, generate_installer, install_offering.",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Validation target information (e.g. cluster_id, region, namespace, etc), in JSON.",
+			},
+		},
+	}
+}
+
+func resourceIBMCmVersionValidationCreate(d *schema.ResourceData, meta interface{}) error {
+	versionLocID := d.Get("version_loc_id").(string)
+	if err := validateCmVersion(d, meta, versionLocID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceIBMCmVersionValidationRead(d, meta)
+}
+
+func resourceIBMCmVersionValidationRead(d *schema.ResourceData, meta interface{}) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+
+	getValidationStatusOptions := catalogManagementClient.NewGetValidationStatusOptions(d.Id(), rsConClient.Config.IAMRefreshToken)
+	validation, response, err := catalogManagementClient.GetValidationStatus(getValidationStatusOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetValidationStatus failed %s\n%s", err, response)
+		return err
+	}
+
+	return setCmVersionValidation(d, validation)
+}
+
+func resourceIBMCmVersionValidationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("cluster_id", "region", "namespace", "override_values", "entitlement_apikey", "schematics", "triggers") {
+		if err := validateCmVersion(d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMCmVersionValidationRead(d, meta)
+}
+
+func resourceIBMCmVersionValidationDelete(d *schema.ResourceData, meta interface{}) error {
+	// A validation is a record of the version, it isn't deleted
+	d.SetId("")
+
+	return nil
+}
+
+// validateCmVersion requests the validation of the version and waits for
+// its result, an invalid version is an error
+func validateCmVersion(d *schema.ResourceData, meta interface{}, versionLocID string, timeout time.Duration) error {
+	catalogManagementClient, err := meta.(conns.ClientSession).CatalogManagementV1()
+	if err != nil {
+		return err
+	}
+	rsConClient, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	refreshToken := rsConClient.Config.IAMRefreshToken
+
+	// The request time of the last validation tells the new validation apart
+	// from the previous one, whose state is returned until the new one starts
+	previousRequested := ""
+	getValidationStatusOptions := catalogManagementClient.NewGetValidationStatusOptions(versionLocID, refreshToken)
+	if validation, _, err := catalogManagementClient.GetValidationStatus(getValidationStatusOptions); err == nil && validation.Requested != nil {
+		previousRequested = validation.Requested.String()
+	}
+
+	validateInstallOptions := catalogManagementClient.NewValidateInstallOptions(versionLocID, refreshToken)
+	if _, ok := d.GetOk("cluster_id"); ok {
+		validateInstallOptions.SetClusterID(d.Get("cluster_id").(string))
+	}
+	if _, ok := d.GetOk("region"); ok {
+		validateInstallOptions.SetRegion(d.Get("region").(string))
+	}
+	if _, ok := d.GetOk("namespace"); ok {
+		validateInstallOptions.SetNamespace(d.Get("namespace").(string))
+	}
+	if v, ok := d.GetOk("override_values"); ok {
+		validateInstallOptions.SetOverrideValues(v.(map[string]interface{}))
+	}
+	if _, ok := d.GetOk("entitlement_apikey"); ok {
+		validateInstallOptions.SetEntitlementApikey(d.Get("entitlement_apikey").(string))
+	}
+	if l, ok := d.GetOk("schematics"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		m := l.([]interface{})[0].(map[string]interface{})
+		validateInstallOptions.SetSchematics(&catalogmanagementv1.DeployRequestBodySchematics{
+			Name:            cmOptionalString(m, "name"),
+			Description:     cmOptionalString(m, "description"),
+			Tags:            flex.ExpandStringList(m["tags"].([]interface{})),
+			ResourceGroupID: cmOptionalString(m, "resource_group_id"),
+		})
+	}
+
+	response, err := catalogManagementClient.ValidateInstall(validateInstallOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ValidateInstall failed %s\n%s", err, response)
+		return fmt.Errorf("[ERROR] Error validating version (%s): %s\n%s", versionLocID, err, response)
+	}
+	d.SetId(versionLocID)
+
+	var validation *catalogmanagementv1.Validation
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inProgress},
+		Target:  []string{cmValidationStateValid},
+		Refresh: func() (interface{}, string, error) {
+			result, response, err := catalogManagementClient.GetValidationStatus(getValidationStatusOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving validation of version (%s): %s\n%s", versionLocID, err, response)
+			}
+			validation = result
+			state := core.StringNilMapper(result.State)
+			if result.Requested == nil || result.Requested.String() == previousRequested || state == cmValidationStateInProgress || state == "" {
+				return result, inProgress, nil
+			}
+			if state != cmValidationStateValid {
+				return result, state, fmt.Errorf("[ERROR] Validation of version (%s) ended with state %s, last operation %s", versionLocID, state, core.StringNilMapper(result.LastOperation))
+			}
+			return result, state, nil
+		},
+		Delay:      waitUntilInterval * 2,
+		MinTimeout: waitUntilInterval,
+		Timeout:    timeout,
+	}
+	_, err = stateConf.WaitForState()
+	if validation != nil {
+		if setErr := setCmVersionValidation(d, validation); setErr != nil {
+			return setErr
+		}
+	}
+	return err
+}
+
+func setCmVersionValidation(d *schema.ResourceData, validation *catalogmanagementv1.Validation) error {
+	if err := d.Set("state", validation.State); err != nil {
+		return fmt.Errorf("[ERROR] Error setting state: %s", err)
+	}
+	if validation.Validated != nil {
+		if err := d.Set("validated", validation.Validated.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting validated: %s", err)
+		}
+	}
+	if validation.Requested != nil {
+		if err := d.Set("requested", validation.Requested.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting requested: %s", err)
+		}
+	}
+	if err := d.Set("last_operation", validation.LastOperation); err != nil {
+		return fmt.Errorf("[ERROR] Error setting last_operation: %s", err)
+	}
+	if validation.Target != nil {
+		target, err := json.Marshal(validation.Target)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error encoding target: %s", err)
+		}
+		if err = d.Set("target", string(target)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting target: %s", err)
+		}
+	}
+	return nil
+}
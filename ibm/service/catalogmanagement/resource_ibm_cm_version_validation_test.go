@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package catalogmanagement_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCmVersionValidation(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCmVersionValidationConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cm_version_validation.cm_version_validation", "state", "valid"),
+					resource.TestCheckResourceAttrSet("ibm_cm_version_validation.cm_version_validation", "validated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCmVersionValidationConfig() string {
+	return `
+
+		resource "ibm_cm_catalog" "cm_catalog" {
+			label = "tf_test_validation_catalog"
+			short_description = "testing terraform provider with catalog"
+		}
+
+		resource "ibm_cm_offering" "cm_offering" {
+			catalog_id = ibm_cm_catalog.cm_catalog.id
+			label = "tf_test_validation_offering"
+			tags = ["dev_ops"]
+		}
+
+		resource "ibm_cm_version" "cm_version" {
+			catalog_identifier = ibm_cm_catalog.cm_catalog.id
+			offering_id = ibm_cm_offering.cm_offering.id
+			zipurl = "https://github.com/IBM-Cloud/terraform-sample/archive/refs/tags/v1.1.0.tar.gz"
+			target_kinds = ["terraform"]
+		}
+
+		resource "ibm_cm_version_validation" "cm_version_validation" {
+			version_loc_id = ibm_cm_version.cm_version.id
+			region = "us-south"
+			override_values = {
+				sample_var = "hello"
+			}
+			schematics {
+				name = "tf_test_validation_workspace"
+			}
+		}
+		`
+}
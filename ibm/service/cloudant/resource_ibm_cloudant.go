@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
@@ -347,6 +348,42 @@ func setCloudantResourceControllerURL(d *schema.ResourceData, meta interface{})
 }
 
 func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.CloudantV1, error) {
+	extensions := make(map[string]string)
+	for k, v := range d.Get("extensions").(map[string]interface{}) {
+		extensions[k] = v.(string)
+	}
+	return newCloudantClient(extensions, meta, false)
+}
+
+// getCloudantClientForInstance returns a client for the Cloudant instance
+// identified by instanceCRN, the endpoints are read from the instance extensions.
+func getCloudantClientForInstance(instanceCRN string, meta interface{}) (*cloudantv1.CloudantV1, error) {
+	extensions := make(map[string]string)
+
+	// The instance lookup is not needed when the endpoint is overridden,
+	// this also allows to target a CouchDB server for testing.
+	if os.Getenv("IBMCLOUD_CLOUDANT_ENDPOINT") == "" {
+		rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return nil, err
+		}
+		resourceInstanceGet := rc.GetResourceInstanceOptions{
+			ID: &instanceCRN,
+		}
+		instance, response, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving Cloudant instance (%s): %s\n%s", instanceCRN, err, response)
+		}
+		extensions = flex.Flatten(instance.Extensions)
+	}
+
+	return newCloudantClient(extensions, meta, true)
+}
+
+// newCloudantClient returns a client for the endpoints of the extensions. When
+// couchDB is set, the IBMCLOUD_CLOUDANT_USERNAME and IBMCLOUD_CLOUDANT_PASSWORD
+// basic authentication of a CouchDB server is used when provided.
+func newCloudantClient(extensions map[string]string, meta interface{}, couchDB bool) (*cloudantv1.CloudantV1, error) {
 
 	session, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
@@ -354,21 +391,20 @@ func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.Cl
 	}
 
 	var endpoint string
-	extensions := d.Get("extensions").(map[string]interface{})
 	if v, ok := extensions["endpoints.public"]; ok {
-		endpoint = "https://" + v.(string)
+		endpoint = "https://" + v
 	}
 
 	switch session.Config.Visibility {
 	case "private":
 		_, ok := extensions["endpoints.private"]
-		if !ok {
+		if !ok && !(couchDB && os.Getenv("IBMCLOUD_CLOUDANT_ENDPOINT") != "") {
 			return nil, fmt.Errorf("[ERROR] Missing endpoints.private in extensions")
 		}
-		endpoint = "https://" + extensions["endpoints.private"].(string)
+		endpoint = "https://" + extensions["endpoints.private"]
 	case "public-and-private":
 		if v, ok := extensions["endpoints.private"]; ok {
-			endpoint = "https://" + v.(string)
+			endpoint = "https://" + v
 		}
 	}
 
@@ -380,7 +416,12 @@ func getCloudantClient(d *schema.ResourceData, meta interface{}) (*cloudantv1.Cl
 	var authenticator core.Authenticator
 	token := session.Config.IAMAccessToken
 
-	if token != "" {
+	if username := os.Getenv("IBMCLOUD_CLOUDANT_USERNAME"); couchDB && username != "" {
+		authenticator = &core.BasicAuthenticator{
+			Username: username,
+			Password: os.Getenv("IBMCLOUD_CLOUDANT_PASSWORD"),
+		}
+	} else if token != "" {
 		token = strings.Replace(token, "Bearer ", "", -1)
 		authenticator = &core.BearerTokenAuthenticator{
 			BearerToken: token,
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+var cloudantDatabaseNameRegexp = regexp.MustCompile(`^[a-z][a-z0-9_$()+/-]*$`)
+
+func ResourceIBMCloudantDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDatabaseCreate,
+		ReadContext:   resourceIBMCloudantDatabaseRead,
+		DeleteContext: resourceIBMCloudantDatabaseDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(cloudantDatabaseNameRegexp, "must start with a lowercase letter and contain only lowercase letters, digits and the characters _, $, (, ), +, - and /"),
+				Description:  "The name of the database.",
+			},
+			"partitioned": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "To create a partitioned database.",
+			},
+			"shards": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of shards in the database. Each shard is a partition of the hash value range.",
+			},
+			"doc_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "A count of the documents in the specified database.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDatabaseCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db := d.Get("db").(string)
+	putDatabaseOptions := &cloudantv1.PutDatabaseOptions{
+		Db:          &db,
+		Partitioned: core.BoolPtr(d.Get("partitioned").(bool)),
+	}
+	if v, ok := d.GetOk("shards"); ok {
+		putDatabaseOptions.Q = core.Int64Ptr(int64(v.(int)))
+	}
+
+	_, response, err := client.PutDatabaseWithContext(context, putDatabaseOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] PutDatabase (%s) failed %s\n%s", db, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, db))
+
+	return resourceIBMCloudantDatabaseRead(context, d, meta)
+}
+
+func resourceIBMCloudantDatabaseRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, err := parseCloudantID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getDatabaseInformationOptions := &cloudantv1.GetDatabaseInformationOptions{
+		Db: &db,
+	}
+	databaseInformation, response, err := client.GetDatabaseInformationWithContext(context, getDatabaseInformationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing Cloudant database (%s) from state because it's not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDatabaseInformation (%s) failed %s\n%s", db, err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", db)
+	partitioned := false
+	if databaseInformation.Props != nil && databaseInformation.Props.Partitioned != nil {
+		partitioned = *databaseInformation.Props.Partitioned
+	}
+	d.Set("partitioned", partitioned)
+	if databaseInformation.Cluster != nil && databaseInformation.Cluster.Q != nil {
+		d.Set("shards", *databaseInformation.Cluster.Q)
+	}
+	if databaseInformation.DocCount != nil {
+		d.Set("doc_count", *databaseInformation.DocCount)
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDatabaseDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, err := parseCloudantID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDatabaseOptions := &cloudantv1.DeleteDatabaseOptions{
+		Db: &db,
+	}
+	_, response, err := client.DeleteDatabaseWithContext(context, deleteDatabaseOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteDatabase (%s) failed %s\n%s", db, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseCloudantID splits an ID of the form <instance_crn>/<path>, the instance
+// CRN always ends with "::" so the path may contain any character.
+func parseCloudantID(id string) (instanceCRN, path string, err error) {
+	parts := strings.SplitN(id, "::/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceCRN/path", id)
+	}
+	return parts[0] + "::", parts[1], nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantDatabase_basic(t *testing.T) {
+	resourceName := "ibm_cloudant_database.database"
+	serviceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(8))
+	dbName := fmt.Sprintf("tf-test-db-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudantDatabaseConfig(serviceName, dbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "db", dbName),
+					resource.TestCheckResourceAttr(resourceName, "partitioned", "true"),
+					resource.TestCheckResourceAttr(resourceName, "shards", "16"),
+					resource.TestCheckResourceAttr(resourceName, "doc_count", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDatabaseConfig(serviceName, dbName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cloudant" "instance" {
+		name     = "%s"
+		plan     = "standard"
+		location = "us-south"
+	}
+
+	resource "ibm_cloudant_database" "database" {
+		instance_crn = ibm_cloudant.instance.crn
+		db           = "%s"
+		partitioned  = true
+		shards       = 16
+	}
+	`, serviceName, dbName)
+}
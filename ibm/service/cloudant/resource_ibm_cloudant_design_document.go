@@ -0,0 +1,450 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMCloudantDesignDocument() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDesignDocumentCreate,
+		ReadContext:   resourceIBMCloudantDesignDocumentRead,
+		UpdateContext: resourceIBMCloudantDesignDocumentUpdate,
+		DeleteContext: resourceIBMCloudantDesignDocumentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the design document, without the _design/ prefix.",
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "javascript",
+				Description: "The language of the design document functions.",
+			},
+			"partitioned": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the views and search indexes of the design document are partitioned.",
+			},
+			"autoupdate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the indexes of the design document are built automatically when documents change.",
+			},
+			"view": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "MapReduce views of the design document.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the view.",
+						},
+						"map": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "JavaScript map function as a string.",
+						},
+						"reduce": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "JavaScript reduce function as a string or the name of a built-in reduce function.",
+						},
+					},
+				},
+			},
+			"search_index": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Search indexes of the design document.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the search index.",
+						},
+						"index": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "JavaScript index function as a string.",
+						},
+						"analyzer": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "standard",
+							Description: "The name of the analyzer used by the search index.",
+						},
+					},
+				},
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The revision of the design document.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantDesignDocumentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db := d.Get("db").(string)
+	name := d.Get("name").(string)
+	putDesignDocumentOptions := &cloudantv1.PutDesignDocumentOptions{
+		Db:             &db,
+		Ddoc:           &name,
+		DesignDocument: expandCloudantDesignDocument(d),
+	}
+
+	_, response, err := client.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] PutDesignDocument (%s) failed %s\n%s", name, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/_design/%s", instanceCRN, db, name))
+
+	return resourceIBMCloudantDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDesignDocumentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, name, err := parseCloudantDesignDocumentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	designDocument, response, err := cloudantDesignDocumentRequest(context, client, core.GET, db, name, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing Cloudant design document (%s) from state because it's not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDesignDocument (%s) failed %s\n%s", name, err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", db)
+	d.Set("name", name)
+	language := "javascript"
+	if v, ok := designDocument["language"].(string); ok {
+		language = v
+	}
+	d.Set("language", language)
+	partitioned := false
+	if options, ok := designDocument["options"].(map[string]interface{}); ok {
+		if v, ok := options["partitioned"].(bool); ok {
+			partitioned = v
+		}
+	}
+	d.Set("partitioned", partitioned)
+	autoupdate := true
+	if v, ok := designDocument["autoupdate"].(bool); ok {
+		autoupdate = v
+	}
+	d.Set("autoupdate", autoupdate)
+
+	views := make([]map[string]interface{}, 0)
+	if designDocumentViews, ok := designDocument["views"].(map[string]interface{}); ok {
+		for viewName, v := range designDocumentViews {
+			view, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			// The map of the views of Mango indexes is an object, these are
+			// managed by ibm_cloudant_index
+			mapFunction, ok := view["map"].(string)
+			if !ok {
+				continue
+			}
+			viewMap := map[string]interface{}{
+				"name": viewName,
+				"map":  mapFunction,
+			}
+			if reduce, ok := view["reduce"].(string); ok {
+				viewMap["reduce"] = reduce
+			}
+			views = append(views, viewMap)
+		}
+	}
+	if err = d.Set("view", views); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting view: %s", err))
+	}
+
+	searchIndexes := make([]map[string]interface{}, 0)
+	if designDocumentIndexes, ok := designDocument["indexes"].(map[string]interface{}); ok {
+		for indexName, i := range designDocumentIndexes {
+			index, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			indexMap := map[string]interface{}{
+				"name":     indexName,
+				"index":    index["index"],
+				"analyzer": "standard",
+			}
+			// The analyzer is either a name or an object with a name
+			switch analyzer := index["analyzer"].(type) {
+			case string:
+				indexMap["analyzer"] = analyzer
+			case map[string]interface{}:
+				if analyzerName, ok := analyzer["name"].(string); ok {
+					indexMap["analyzer"] = analyzerName
+				}
+			}
+			searchIndexes = append(searchIndexes, indexMap)
+		}
+	}
+	if err = d.Set("search_index", searchIndexes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting search_index: %s", err))
+	}
+
+	d.Set("revision", designDocument["_rev"])
+
+	return nil
+}
+
+func resourceIBMCloudantDesignDocumentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, name, err := parseCloudantDesignDocumentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The current design document is updated in place, so that the Mango
+	// indexes and the other functions stored in it are kept
+	designDocument, response, err := cloudantDesignDocumentRequest(context, client, core.GET, db, name, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDesignDocument (%s) failed %s\n%s", name, err, response))
+	}
+
+	designDocument["language"] = d.Get("language").(string)
+	designDocument["autoupdate"] = d.Get("autoupdate").(bool)
+	options, _ := designDocument["options"].(map[string]interface{})
+	if d.Get("partitioned").(bool) {
+		if options == nil {
+			options = make(map[string]interface{})
+		}
+		options["partitioned"] = true
+		designDocument["options"] = options
+	} else if options != nil {
+		delete(options, "partitioned")
+		if len(options) == 0 {
+			delete(designDocument, "options")
+		}
+	}
+
+	oldViews, newViews := d.GetChange("view")
+	views, _ := designDocument["views"].(map[string]interface{})
+	if views == nil {
+		views = make(map[string]interface{})
+	}
+	for _, v := range oldViews.(*schema.Set).List() {
+		delete(views, v.(map[string]interface{})["name"].(string))
+	}
+	for _, v := range newViews.(*schema.Set).List() {
+		view := v.(map[string]interface{})
+		mapReduce := map[string]interface{}{
+			"map": view["map"].(string),
+		}
+		if reduce := view["reduce"].(string); reduce != "" {
+			mapReduce["reduce"] = reduce
+		}
+		views[view["name"].(string)] = mapReduce
+	}
+	if len(views) > 0 {
+		designDocument["views"] = views
+	} else {
+		delete(designDocument, "views")
+	}
+
+	oldSearchIndexes, newSearchIndexes := d.GetChange("search_index")
+	searchIndexes, _ := designDocument["indexes"].(map[string]interface{})
+	if searchIndexes == nil {
+		searchIndexes = make(map[string]interface{})
+	}
+	for _, i := range oldSearchIndexes.(*schema.Set).List() {
+		delete(searchIndexes, i.(map[string]interface{})["name"].(string))
+	}
+	for _, i := range newSearchIndexes.(*schema.Set).List() {
+		searchIndex := i.(map[string]interface{})
+		searchIndexes[searchIndex["name"].(string)] = map[string]interface{}{
+			"index": searchIndex["index"].(string),
+			"analyzer": map[string]interface{}{
+				"name": searchIndex["analyzer"].(string),
+			},
+		}
+	}
+	if len(searchIndexes) > 0 {
+		designDocument["indexes"] = searchIndexes
+	} else {
+		delete(designDocument, "indexes")
+	}
+
+	_, response, err = cloudantDesignDocumentRequest(context, client, core.PUT, db, name, designDocument)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] PutDesignDocument (%s) failed %s\n%s", name, err, response))
+	}
+
+	return resourceIBMCloudantDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDesignDocumentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, name, err := parseCloudantDesignDocumentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDesignDocumentOptions := &cloudantv1.DeleteDesignDocumentOptions{
+		Db:   &db,
+		Ddoc: &name,
+		Rev:  core.StringPtr(d.Get("revision").(string)),
+	}
+	_, response, err := client.DeleteDesignDocumentWithContext(context, deleteDesignDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteDesignDocument (%s) failed %s\n%s", name, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandCloudantDesignDocument(d *schema.ResourceData) *cloudantv1.DesignDocument {
+	designDocument := &cloudantv1.DesignDocument{
+		Language:   core.StringPtr(d.Get("language").(string)),
+		Autoupdate: core.BoolPtr(d.Get("autoupdate").(bool)),
+	}
+	// Design documents are global by default, options are only sent for partitioned ones.
+	if d.Get("partitioned").(bool) {
+		designDocument.Options = &cloudantv1.DesignDocumentOptions{
+			Partitioned: core.BoolPtr(true),
+		}
+	}
+
+	if views := d.Get("view").(*schema.Set).List(); len(views) > 0 {
+		designDocument.Views = make(map[string]cloudantv1.DesignDocumentViewsMapReduce)
+		for _, v := range views {
+			view := v.(map[string]interface{})
+			mapReduce := cloudantv1.DesignDocumentViewsMapReduce{
+				Map: core.StringPtr(view["map"].(string)),
+			}
+			if reduce := view["reduce"].(string); reduce != "" {
+				mapReduce.Reduce = core.StringPtr(reduce)
+			}
+			designDocument.Views[view["name"].(string)] = mapReduce
+		}
+	}
+
+	if searchIndexes := d.Get("search_index").(*schema.Set).List(); len(searchIndexes) > 0 {
+		designDocument.Indexes = make(map[string]cloudantv1.SearchIndexDefinition)
+		for _, i := range searchIndexes {
+			searchIndex := i.(map[string]interface{})
+			designDocument.Indexes[searchIndex["name"].(string)] = cloudantv1.SearchIndexDefinition{
+				Index: core.StringPtr(searchIndex["index"].(string)),
+				Analyzer: &cloudantv1.AnalyzerConfiguration{
+					Name: core.StringPtr(searchIndex["analyzer"].(string)),
+				},
+			}
+		}
+	}
+
+	return designDocument
+}
+
+// cloudantDesignDocumentRequest sends a request for the design document with
+// a raw body, as the SDK model can't carry the views of the Mango indexes
+func cloudantDesignDocumentRequest(context context.Context, client *cloudantv1.CloudantV1, method, db, name string, body map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	pathParams := map[string]string{
+		"db":   db,
+		"ddoc": name,
+	}
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, `/{db}/_design/{ddoc}`, pathParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	result := make(map[string]interface{})
+	response, err := client.Service.Request(request, &result)
+	return result, response, err
+}
+
+// parseCloudantDesignDocumentID splits an ID of the form <instance_crn>/<db>/_design/<name>
+func parseCloudantDesignDocumentID(id string) (instanceCRN, db, name string, err error) {
+	instanceCRN, path, err := parseCloudantID(id)
+	if err != nil {
+		return "", "", "", err
+	}
+	parts := strings.SplitN(path, "/_design/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceCRN/db/_design/name", id)
+	}
+	return instanceCRN, parts[0], parts[1], nil
+}
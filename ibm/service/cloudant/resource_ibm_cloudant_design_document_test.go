@@ -0,0 +1,83 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantDesignDocument_basic(t *testing.T) {
+	resourceName := "ibm_cloudant_design_document.ddoc"
+	serviceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(8))
+	dbName := fmt.Sprintf("tf-test-db-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudantDesignDocumentConfig(serviceName, dbName, "_count"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "products"),
+					resource.TestCheckResourceAttr(resourceName, "language", "javascript"),
+					resource.TestCheckResourceAttr(resourceName, "view.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "search_index.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCloudantDesignDocumentConfig(serviceName, dbName, "_sum"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "view.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "revision", regexp.MustCompile(`^2-`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDesignDocumentConfig(serviceName, dbName, reduce string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cloudant" "instance" {
+		name     = "%s"
+		plan     = "standard"
+		location = "us-south"
+	}
+
+	resource "ibm_cloudant_database" "database" {
+		instance_crn = ibm_cloudant.instance.crn
+		db           = "%s"
+	}
+
+	resource "ibm_cloudant_design_document" "ddoc" {
+		instance_crn = ibm_cloudant.instance.crn
+		db           = ibm_cloudant_database.database.db
+		name         = "products"
+
+		view {
+			name   = "by_price"
+			map    = "function (doc) { emit(doc.price, 1); }"
+			reduce = "%s"
+		}
+
+		search_index {
+			name  = "by_name"
+			index = "function (doc) { index(\"name\", doc.name); }"
+		}
+	}
+	`, serviceName, dbName, reduce)
+}
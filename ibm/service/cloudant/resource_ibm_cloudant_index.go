@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMCloudantIndex() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantIndexCreate,
+		ReadContext:   resourceIBMCloudantIndexRead,
+		DeleteContext: resourceIBMCloudantIndexDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the database.",
+			},
+			"ddoc": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The name of the design document in which the index is created, without the _design/ prefix. A name is generated when it is not provided.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The name of the index. A name is generated when it is not provided.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json", "text"}, false),
+				Description:  "The type of the index.",
+			},
+			"partitioned": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether the index is partitioned. Defaults to the partitioning of the database.",
+			},
+			"field": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The fields to index.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The name of the field.",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"asc", "desc", "boolean", "number", "string"}, false),
+							Description:  "The sort order of the field for json indexes (asc or desc), the type of the field for text indexes (boolean, number or string).",
+						},
+					},
+				},
+			},
+			"partial_filter_selector": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "JSON selector to restrict the documents included in the index.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantIndexCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db := d.Get("db").(string)
+	indexType := d.Get("type").(string)
+
+	indexDefinition := &cloudantv1.IndexDefinition{}
+	for _, f := range d.Get("field").([]interface{}) {
+		field := f.(map[string]interface{})
+		fieldName := field["name"].(string)
+		fieldType := field["type"].(string)
+		indexField := cloudantv1.IndexField{}
+		if indexType == "json" {
+			// json index fields are defined as {"<name>": "<asc|desc>"}
+			if fieldType == "" {
+				fieldType = "asc"
+			}
+			indexField.SetProperty(fieldName, core.StringPtr(fieldType))
+		} else {
+			if fieldType == "" {
+				fieldType = "string"
+			}
+			indexField.Name = core.StringPtr(fieldName)
+			indexField.Type = core.StringPtr(fieldType)
+		}
+		indexDefinition.Fields = append(indexDefinition.Fields, indexField)
+	}
+	if v, ok := d.GetOk("partial_filter_selector"); ok {
+		var selector map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &selector); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing partial_filter_selector: %s", err))
+		}
+		indexDefinition.PartialFilterSelector = selector
+	}
+
+	postIndexOptions := &cloudantv1.PostIndexOptions{
+		Db:    &db,
+		Index: indexDefinition,
+		Type:  &indexType,
+	}
+	if v, ok := d.GetOk("ddoc"); ok {
+		postIndexOptions.Ddoc = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("name"); ok {
+		postIndexOptions.Name = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOkExists("partitioned"); ok {
+		postIndexOptions.Partitioned = core.BoolPtr(v.(bool))
+	}
+
+	indexResult, response, err := client.PostIndexWithContext(context, postIndexOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] PostIndex (%s) failed %s\n%s", db, err, response))
+	}
+
+	// The returned id is the design document id, _design/<ddoc>
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/%s", instanceCRN, db, *indexResult.ID, indexType, *indexResult.Name))
+
+	return resourceIBMCloudantIndexRead(context, d, meta)
+}
+
+func resourceIBMCloudantIndexRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, ddoc, indexType, name, err := parseCloudantIndexID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getIndexesInformationOptions := &cloudantv1.GetIndexesInformationOptions{
+		Db: &db,
+	}
+	indexesInformation, response, err := client.GetIndexesInformationWithContext(context, getIndexesInformationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing Cloudant index (%s) from state because the database is not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetIndexesInformation (%s) failed %s\n%s", db, err, response))
+	}
+
+	var index *cloudantv1.IndexInformation
+	for i := range indexesInformation.Indexes {
+		info := indexesInformation.Indexes[i]
+		if info.Ddoc != nil && *info.Ddoc == "_design/"+ddoc && info.Name != nil && *info.Name == name {
+			index = &indexesInformation.Indexes[i]
+			break
+		}
+	}
+	if index == nil {
+		log.Printf("[WARN] Removing Cloudant index (%s) from state because it's not found via the API", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", db)
+	d.Set("ddoc", ddoc)
+	d.Set("type", indexType)
+	d.Set("name", name)
+
+	if index.Def != nil {
+		fields := make([]map[string]interface{}, 0, len(index.Def.Fields))
+		for _, indexField := range index.Def.Fields {
+			if indexField.Name != nil {
+				field := map[string]interface{}{
+					"name": *indexField.Name,
+				}
+				if indexField.Type != nil {
+					field["type"] = *indexField.Type
+				}
+				fields = append(fields, field)
+				continue
+			}
+			// Both index types are returned as {"<name>": "<type>"}
+			for fieldName, fieldType := range indexField.GetProperties() {
+				field := map[string]interface{}{
+					"name": fieldName,
+				}
+				if fieldType != nil {
+					field["type"] = *fieldType
+				}
+				fields = append(fields, field)
+			}
+		}
+		if err = d.Set("field", fields); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting field: %s", err))
+		}
+
+		if len(index.Def.PartialFilterSelector) > 0 {
+			selector, err := json.Marshal(index.Def.PartialFilterSelector)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling partial_filter_selector: %s", err))
+			}
+			d.Set("partial_filter_selector", string(selector))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantIndexDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, db, ddoc, indexType, name, err := parseCloudantIndexID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteIndexOptions := &cloudantv1.DeleteIndexOptions{
+		Db:    &db,
+		Ddoc:  &ddoc,
+		Type:  &indexType,
+		Index: &name,
+	}
+	_, response, err := client.DeleteIndexWithContext(context, deleteIndexOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteIndex (%s) failed %s\n%s", name, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseCloudantIndexID splits an ID of the form <instance_crn>/<db>/_design/<ddoc>/<type>/<name>
+func parseCloudantIndexID(id string) (instanceCRN, db, ddoc, indexType, name string, err error) {
+	instanceCRN, path, err := parseCloudantID(id)
+	if err != nil {
+		return "", "", "", "", "", err
+	}
+	parts := strings.SplitN(path, "/_design/", 2)
+	if len(parts) == 2 {
+		indexParts := strings.SplitN(parts[1], "/", 3)
+		if parts[0] != "" && len(indexParts) == 3 && indexParts[0] != "" && indexParts[1] != "" && indexParts[2] != "" {
+			return instanceCRN, parts[0], indexParts[0], indexParts[1], indexParts[2], nil
+		}
+	}
+	return "", "", "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceCRN/db/_design/ddoc/type/name", id)
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantIndex_basic(t *testing.T) {
+	resourceName := "ibm_cloudant_index.index"
+	serviceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(8))
+	dbName := fmt.Sprintf("tf-test-db-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudantIndexConfig(serviceName, dbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ddoc", "by-type"),
+					resource.TestCheckResourceAttr(resourceName, "name", "type-price"),
+					resource.TestCheckResourceAttr(resourceName, "type", "json"),
+					resource.TestCheckResourceAttr(resourceName, "field.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "field.0.name", "type"),
+					resource.TestCheckResourceAttr(resourceName, "field.0.type", "asc"),
+					resource.TestCheckResourceAttr(resourceName, "field.1.name", "price"),
+					resource.TestCheckResourceAttr(resourceName, "field.1.type", "asc"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partitioned"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantIndexConfig(serviceName, dbName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cloudant" "instance" {
+		name     = "%s"
+		plan     = "standard"
+		location = "us-south"
+	}
+
+	resource "ibm_cloudant_database" "database" {
+		instance_crn = ibm_cloudant.instance.crn
+		db           = "%s"
+	}
+
+	resource "ibm_cloudant_index" "index" {
+		instance_crn = ibm_cloudant.instance.crn
+		db           = ibm_cloudant_database.database.db
+		ddoc         = "by-type"
+		name         = "type-price"
+
+		field {
+			name = "type"
+		}
+		field {
+			name = "price"
+		}
+
+		partial_filter_selector = jsonencode({
+			price = { "$gt" = 0 }
+		})
+	}
+	`, serviceName, dbName)
+}
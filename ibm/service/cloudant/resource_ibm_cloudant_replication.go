@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMCloudantReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantReplicationCreate,
+		ReadContext:   resourceIBMCloudantReplicationRead,
+		DeleteContext: resourceIBMCloudantReplicationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN, the replication document is stored in its _replicator database.",
+			},
+			"replication_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the replication document.",
+			},
+			"source_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the source database.",
+			},
+			"source_api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key used to authenticate with the source database.",
+			},
+			"target_url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the target database.",
+			},
+			"target_api_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key used to authenticate with the target database.",
+			},
+			"continuous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Configure the replication to be continuous.",
+			},
+			"create_target": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Creates the target database.",
+			},
+			"selector": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: flex.SuppressEquivalentJSON,
+				Description:      "JSON selector to filter the documents that are replicated.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the replication, as reported by the replication scheduler.",
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The revision of the replication document.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replicationID := d.Get("replication_id").(string)
+	replicationDocument := &cloudantv1.ReplicationDocument{
+		Source:       expandCloudantReplicationDatabase(d.Get("source_url").(string), d.Get("source_api_key").(string)),
+		Target:       expandCloudantReplicationDatabase(d.Get("target_url").(string), d.Get("target_api_key").(string)),
+		Continuous:   core.BoolPtr(d.Get("continuous").(bool)),
+		CreateTarget: core.BoolPtr(d.Get("create_target").(bool)),
+	}
+	if v, ok := d.GetOk("selector"); ok {
+		var selector map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &selector); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error parsing selector: %s", err))
+		}
+		replicationDocument.Selector = selector
+	}
+
+	putReplicationDocumentOptions := &cloudantv1.PutReplicationDocumentOptions{
+		DocID:               &replicationID,
+		ReplicationDocument: replicationDocument,
+	}
+	_, response, err := client.PutReplicationDocumentWithContext(context, putReplicationDocumentOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] PutReplicationDocument (%s) failed %s\n%s", replicationID, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, replicationID))
+
+	return resourceIBMCloudantReplicationRead(context, d, meta)
+}
+
+func resourceIBMCloudantReplicationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, replicationID, err := parseCloudantID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getReplicationDocumentOptions := &cloudantv1.GetReplicationDocumentOptions{
+		DocID: &replicationID,
+	}
+	replicationDocument, response, err := client.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing Cloudant replication (%s) from state because it's not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetReplicationDocument (%s) failed %s\n%s", replicationID, err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("replication_id", replicationID)
+	if replicationDocument.Source != nil {
+		d.Set("source_url", replicationDocument.Source.URL)
+	}
+	if replicationDocument.Target != nil {
+		d.Set("target_url", replicationDocument.Target.URL)
+	}
+	d.Set("continuous", replicationDocument.Continuous != nil && *replicationDocument.Continuous)
+	d.Set("create_target", replicationDocument.CreateTarget != nil && *replicationDocument.CreateTarget)
+	if len(replicationDocument.Selector) > 0 {
+		selector, err := json.Marshal(replicationDocument.Selector)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error marshalling selector: %s", err))
+		}
+		d.Set("selector", string(selector))
+	}
+	d.Set("revision", replicationDocument.Rev)
+
+	// The scheduler only knows about the replication once it has been picked up,
+	// the state is left empty until then.
+	getSchedulerDocumentOptions := &cloudantv1.GetSchedulerDocumentOptions{
+		DocID: &replicationID,
+	}
+	schedulerDocument, response, err := client.GetSchedulerDocumentWithContext(context, getSchedulerDocumentOptions)
+	if err != nil {
+		if response == nil || response.StatusCode != 404 {
+			return diag.FromErr(fmt.Errorf("[ERROR] GetSchedulerDocument (%s) failed %s\n%s", replicationID, err, response))
+		}
+		d.Set("state", "")
+	} else {
+		d.Set("state", schedulerDocument.State)
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, replicationID, err := parseCloudantID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := getCloudantClientForInstance(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteReplicationDocumentOptions := &cloudantv1.DeleteReplicationDocumentOptions{
+		DocID: &replicationID,
+		Rev:   core.StringPtr(d.Get("revision").(string)),
+	}
+	_, response, err := client.DeleteReplicationDocumentWithContext(context, deleteReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteReplicationDocument (%s) failed %s\n%s", replicationID, err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandCloudantReplicationDatabase(url, apiKey string) *cloudantv1.ReplicationDatabase {
+	replicationDatabase := &cloudantv1.ReplicationDatabase{
+		URL: core.StringPtr(url),
+	}
+	if apiKey != "" {
+		replicationDatabase.Auth = &cloudantv1.ReplicationDatabaseAuth{
+			Iam: &cloudantv1.ReplicationDatabaseAuthIam{
+				ApiKey: core.StringPtr(apiKey),
+			},
+		}
+	}
+	return replicationDatabase
+}
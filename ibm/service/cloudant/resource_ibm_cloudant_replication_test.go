@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCloudantReplication_basic(t *testing.T) {
+	resourceName := "ibm_cloudant_replication.replication"
+	serviceName := fmt.Sprintf("terraform-test-%s", acctest.RandString(8))
+	dbName := fmt.Sprintf("tf-test-db-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCloudantReplicationConfig(serviceName, dbName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "replication_id", "tf-test-replication"),
+					resource.TestCheckResourceAttr(resourceName, "continuous", "true"),
+					resource.TestCheckResourceAttr(resourceName, "create_target", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_api_key", "target_api_key", "state"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantReplicationConfig(serviceName, dbName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cloudant" "instance" {
+		name     = "%[1]s"
+		plan     = "standard"
+		location = "us-south"
+	}
+
+	resource "ibm_cloudant_database" "database" {
+		instance_crn = ibm_cloudant.instance.crn
+		db           = "%[2]s"
+	}
+
+	resource "ibm_resource_key" "key" {
+		name                 = "%[1]s-key"
+		role                 = "Manager"
+		resource_instance_id = ibm_cloudant.instance.id
+	}
+
+	resource "ibm_cloudant_replication" "replication" {
+		instance_crn   = ibm_cloudant.instance.crn
+		replication_id = "tf-test-replication"
+		source_url     = "${ibm_resource_key.key.credentials.url}/${ibm_cloudant_database.database.db}"
+		source_api_key = ibm_resource_key.key.credentials.apikey
+		target_url     = "${ibm_resource_key.key.credentials.url}/${ibm_cloudant_database.database.db}-copy"
+		target_api_key = ibm_resource_key.key.credentials.apikey
+		continuous     = true
+		create_target  = true
+	}
+	`, serviceName, dbName)
+}
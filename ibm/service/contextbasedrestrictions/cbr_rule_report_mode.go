@@ -0,0 +1,292 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	cbrEnforcementModeEnabled  = "enabled"
+	cbrEnforcementModeDisabled = "disabled"
+	cbrEnforcementModeReport   = "report"
+
+	cbrRuleEvalAction     = "context-based-restrictions.policy.eval"
+	cbrRuleDenialDecision = "Deny"
+)
+
+// cbrRule is the rule of the API with its enforcement mode, which the SDK
+// model doesn't carry
+type cbrRule struct {
+	contextbasedrestrictionsv1.Rule
+	EnforcementMode *string `json:"enforcement_mode,omitempty"`
+}
+
+// cbrRuleRequest sends a rule request and decodes the response in result
+func cbrRuleRequest(context context.Context, client *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, method, path string, pathParams map[string]string, etag string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if etag != "" {
+		builder.AddHeader("If-Match", etag)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// cbrRuleBody builds the body of a create or replace request
+func cbrRuleBody(description *string, contexts []contextbasedrestrictionsv1.RuleContext, resources []contextbasedrestrictionsv1.Resource, enforcementMode string) map[string]interface{} {
+	body := map[string]interface{}{
+		"contexts":         contexts,
+		"resources":        resources,
+		"enforcement_mode": enforcementMode,
+	}
+	if description != nil {
+		body["description"] = description
+	}
+	return body
+}
+
+// setCbrRuleEnforcementMode replaces the rule ruleID with its enforcement mode
+// set to mode
+func setCbrRuleEnforcementMode(context context.Context, client *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, ruleID, mode string) error {
+	rule := &cbrRule{}
+	response, err := cbrRuleRequest(context, client, core.GET, "/v1/rules/{rule_id}", map[string]string{"rule_id": ruleID}, "", nil, rule)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error getting rule (%s): %s\n%s", ruleID, err, response)
+	}
+	body := cbrRuleBody(rule.Description, rule.Contexts, rule.Resources, mode)
+	response, err = cbrRuleRequest(context, client, core.PUT, "/v1/rules/{rule_id}", map[string]string{"rule_id": ruleID}, response.Headers.Get("Etag"), body, &cbrRule{})
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error setting enforcement mode of rule (%s) to %s: %s\n%s", ruleID, mode, err, response)
+	}
+	return nil
+}
+
+// cbrRuleReportMode is the report_mode block of ibm_cbr_rule
+type cbrRuleReportMode struct {
+	window       time.Duration
+	pollInterval time.Duration
+	region       string
+	endpointType string
+	serviceKey   string
+	query        string
+	maxDenials   int
+}
+
+func expandCbrRuleReportMode(d *schema.ResourceData) (*cbrRuleReportMode, error) {
+	l, ok := d.Get("report_mode").([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	m := l[0].(map[string]interface{})
+	window, err := time.ParseDuration(m["window"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing report_mode window: %s", err)
+	}
+	pollInterval, err := time.ParseDuration(m["poll_interval"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing report_mode poll_interval: %s", err)
+	}
+	return &cbrRuleReportMode{
+		window:       window,
+		pollInterval: pollInterval,
+		region:       m["activity_tracker_region"].(string),
+		endpointType: m["activity_tracker_endpoint_type"].(string),
+		serviceKey:   m["activity_tracker_service_key"].(string),
+		query:        m["query"].(string),
+		maxDenials:   m["max_denials"].(int),
+	}, nil
+}
+
+// cbrRuleNeedsReportMode tells if the rule has to go through the report
+// window before it is enforced: the rule is new, it's switched to enabled
+// from another mode, or its contexts or resources change while enabled
+func cbrRuleNeedsReportMode(d *schema.ResourceData) bool {
+	if d.Get("enforcement_mode").(string) != cbrEnforcementModeEnabled {
+		return false
+	}
+	if _, ok := d.GetOk("report_mode"); !ok {
+		return false
+	}
+	if d.IsNewResource() {
+		return true
+	}
+	return d.HasChange("enforcement_mode") || d.HasChange("contexts") || d.HasChange("resources")
+}
+
+// checkCbrRuleReportMode checks that the report window fits in the timeout and
+// that Activity Tracker can be searched, before the rule is sent, so that an
+// invalid report_mode doesn't leave the rule in report mode
+func checkCbrRuleReportMode(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	reportMode, err := expandCbrRuleReportMode(d)
+	if err != nil || reportMode == nil {
+		return err
+	}
+	if reportMode.window >= timeout {
+		return fmt.Errorf("[ERROR] The report_mode window %s has to be shorter than the timeout %s", reportMode.window, timeout)
+	}
+	_, err = meta.(conns.ClientSession).ActivityTrackerLoggingAPI(reportMode.region, reportMode.endpointType)
+	return err
+}
+
+// enforceCbrRuleAfterReport keeps the rule ruleID in report mode over the
+// report window and polls Activity Tracker for the requests the rule would
+// have denied. The rule is enforced when the denials stay within
+// max_denials, otherwise it is left in report mode and an error is returned.
+func enforceCbrRuleAfterReport(context context.Context, d *schema.ResourceData, meta interface{}, client *contextbasedrestrictionsv1.ContextBasedRestrictionsV1, ruleID string, timeout time.Duration) error {
+	reportMode, err := expandCbrRuleReportMode(d)
+	if err != nil {
+		return err
+	}
+	loggingClient, err := meta.(conns.ClientSession).ActivityTrackerLoggingAPI(reportMode.region, reportMode.endpointType)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	end := start.Add(reportMode.window)
+	log.Printf("[INFO] Rule (%s) is in report mode until %s", ruleID, end.UTC().Format(time.RFC3339))
+	denials := 0
+	for {
+		wait := reportMode.pollInterval
+		if remaining := time.Until(end); remaining < wait {
+			wait = remaining
+		}
+		if wait > 0 {
+			select {
+			case <-context.Done():
+				return fmt.Errorf("[ERROR] Report window of rule (%s) was interrupted: %s", ruleID, context.Err())
+			case <-time.After(wait):
+			}
+		}
+		denials, err = countCbrRuleDenials(context, loggingClient, reportMode, ruleID, start, time.Now())
+		if err != nil {
+			return err
+		}
+		d.Set("report_mode_denials", denials)
+		if denials > reportMode.maxDenials {
+			return fmt.Errorf("[ERROR] Rule (%s) would have denied %d requests in report mode, more than the %d allowed by report_mode, the rule is left in report mode", ruleID, denials, reportMode.maxDenials)
+		}
+		if !time.Now().Before(end) {
+			break
+		}
+		log.Printf("[DEBUG] Rule (%s) would have denied %d requests so far", ruleID, denials)
+	}
+
+	log.Printf("[INFO] Rule (%s) would have denied %d requests in report mode, enforcing it", ruleID, denials)
+	return setCbrRuleEnforcementMode(context, client, ruleID, cbrEnforcementModeEnabled)
+}
+
+// countCbrRuleDenials counts the Activity Tracker events between from and to
+// that match the report_mode query, or the rule ID when no query is set, and
+// carry a Deny decision
+func countCbrRuleDenials(context context.Context, loggingClient *core.BaseService, reportMode *cbrRuleReportMode, ruleID string, from, to time.Time) (int, error) {
+	query := reportMode.query
+	if query == "" {
+		query = ruleID
+	}
+	queryParams := map[string]string{
+		"from":  strconv.FormatInt(from.Unix(), 10),
+		"to":    strconv.FormatInt(to.Unix(), 10),
+		"query": query,
+		"size":  "10000",
+	}
+
+	denials := 0
+	for {
+		builder := core.NewRequestBuilder(core.GET)
+		builder = builder.WithContext(context)
+		if _, err := builder.ResolveRequestURL(loggingClient.Options.URL, "/v2/export", nil); err != nil {
+			return 0, err
+		}
+		builder.AddHeader("Accept", "application/json")
+		builder.AddHeader("servicekey", reportMode.serviceKey)
+		for k, v := range queryParams {
+			builder.AddQuery(k, v)
+		}
+		request, err := builder.Build()
+		if err != nil {
+			return 0, err
+		}
+
+		result := struct {
+			Lines        []interface{} `json:"lines"`
+			PaginationID *string       `json:"pagination_id"`
+		}{}
+		response, err := loggingClient.Request(request, &result)
+		if err != nil {
+			return 0, fmt.Errorf("[ERROR] Error searching the Activity Tracker events of rule (%s): %s\n%s", ruleID, err, response)
+		}
+		for _, line := range result.Lines {
+			if cbrEventDenied(line) {
+				denials++
+			}
+		}
+		if result.PaginationID == nil || *result.PaginationID == "" || len(result.Lines) == 0 {
+			break
+		}
+		queryParams["pagination_id"] = *result.PaginationID
+	}
+	return denials, nil
+}
+
+// cbrEventDenied tells if an exported line is a rule evaluation event with a
+// Deny decision. The raw event is exported as a JSON string in _line, next to
+// the fields that are parsed from it.
+func cbrEventDenied(line interface{}) bool {
+	event, ok := line.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	if raw, ok := event["_line"].(string); ok {
+		var rawEvent map[string]interface{}
+		if json.Unmarshal([]byte(raw), &rawEvent) == nil {
+			event = rawEvent
+		}
+	}
+	if action, _ := event["action"].(string); action != cbrRuleEvalAction {
+		return false
+	}
+	requestData, ok := event["requestData"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	decision, _ := requestData["decision"].(string)
+	return decision == cbrRuleDenialDecision
+}
+
+func validateCbrRuleDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 30m or 2h: %s", k, err))
+	} else if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %s", k, v))
+	}
+	return
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCbrEventDenied(t *testing.T) {
+	event := func(action, decision string) map[string]interface{} {
+		return map[string]interface{}{
+			"action":  action,
+			"outcome": "failure",
+			"requestData": map[string]interface{}{
+				"decision":   decision,
+				"isEnforced": false,
+			},
+		}
+	}
+	rawLine := func(e map[string]interface{}) map[string]interface{} {
+		raw, _ := json.Marshal(e)
+		return map[string]interface{}{"_line": string(raw), "_app": "activity-tracker"}
+	}
+
+	cases := []struct {
+		name   string
+		line   interface{}
+		denied bool
+	}{
+		{"deny", event(cbrRuleEvalAction, "Deny"), true},
+		{"permit", event(cbrRuleEvalAction, "Permit"), false},
+		{"deny in raw line", rawLine(event(cbrRuleEvalAction, "Deny")), true},
+		{"permit in raw line", rawLine(event(cbrRuleEvalAction, "Permit")), false},
+		{"deny of another action", event("iam-identity.serviceid.get", "Deny"), false},
+		{"deny outside the decision", map[string]interface{}{
+			"action":      cbrRuleEvalAction,
+			"reason":      map[string]interface{}{"reasonType": "Deny"},
+			"requestData": map[string]interface{}{"decision": "Permit", "ruleName": "Deny"},
+		}, false},
+		{"deny in message", map[string]interface{}{"message": "Deny"}, false},
+		{"string line", "Deny", false},
+		{"no request data", map[string]interface{}{"action": cbrRuleEvalAction}, false},
+	}
+	for _, c := range cases {
+		if denied := cbrEventDenied(c.line); denied != c.denied {
+			t.Errorf("%s: cbrEventDenied returned %t, expected %t", c.name, denied, c.denied)
+		}
+	}
+}
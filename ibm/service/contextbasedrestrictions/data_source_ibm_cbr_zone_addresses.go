@@ -0,0 +1,362 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// DataSourceIBMCbrZoneAddresses expands the addresses of a zone, minus its
+// excluded addresses, into the effective IP ranges, VPCs and service references
+func DataSourceIBMCbrZoneAddresses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrZoneAddressesRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of a zone.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the zone.",
+			},
+			"ip_ranges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The effective IP ranges of the zone, the IP addresses, ranges and subnets of the zone merged together without the excluded addresses.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The first IP address of the range.",
+						},
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The last IP address of the range.",
+						},
+						"ip_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP version of the range, ipv4 or ipv6.",
+						},
+						"cidrs": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The smallest list of CIDR blocks that covers the range.",
+						},
+					},
+				},
+			},
+			"cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CIDR blocks of all the effective IP ranges of the zone.",
+			},
+			"ipv4_address_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of IPv4 addresses in the effective IP ranges.",
+			},
+			"vpc_crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the VPCs of the zone.",
+			},
+			"service_refs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The service references of the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The id of the account owning the service.",
+						},
+						"service_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service type.",
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service name.",
+						},
+						"service_instance": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The service instance.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrZoneAddressesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	contextBasedRestrictionsClient, err := meta.(conns.ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getZoneOptions := &contextbasedrestrictionsv1.GetZoneOptions{}
+	getZoneOptions.SetZoneID(d.Get("zone_id").(string))
+
+	zone, response, err := contextBasedRestrictionsClient.GetZoneWithContext(context, getZoneOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetZoneWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetZoneWithContext failed %s\n%s", err, response))
+	}
+
+	included := []cbrIPRange{}
+	vpcCRNs := []string{}
+	serviceRefs := []map[string]interface{}{}
+	for _, address := range zone.Addresses {
+		a := dataSourceZoneAddressesToMap(address)
+		addressType, _ := a["type"].(string)
+		value, _ := a["value"].(string)
+		switch addressType {
+		case contextbasedrestrictionsv1.AddressTypeVPCConst:
+			vpcCRNs = append(vpcCRNs, value)
+		case contextbasedrestrictionsv1.AddressTypeServicerefConst:
+			if ref, ok := a["ref"].(map[string]interface{}); ok {
+				serviceRefs = append(serviceRefs, ref)
+			}
+		default:
+			r, err := parseCbrIPRange(addressType, value)
+			if err != nil {
+				return diag.Errorf("[ERROR] Error expanding the addresses of zone (%s): %s", *zone.ID, err)
+			}
+			included = append(included, r)
+		}
+	}
+	excluded := []cbrIPRange{}
+	for _, address := range zone.Excluded {
+		a := dataSourceZoneExcludedToMap(address)
+		addressType, _ := a["type"].(string)
+		value, _ := a["value"].(string)
+		r, err := parseCbrIPRange(addressType, value)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error expanding the excluded addresses of zone (%s): %s", *zone.ID, err)
+		}
+		excluded = append(excluded, r)
+	}
+
+	ranges := subtractCbrIPRanges(mergeCbrIPRanges(included), mergeCbrIPRanges(excluded))
+	ipRanges := make([]map[string]interface{}, 0, len(ranges))
+	cidrs := []string{}
+	ipv4Count := new(big.Int)
+	for _, r := range ranges {
+		rangeCIDRs := r.cidrs()
+		ipVersion := "ipv6"
+		if r.bits == 32 {
+			ipVersion = "ipv4"
+			ipv4Count.Add(ipv4Count, r.size())
+		}
+		ipRanges = append(ipRanges, map[string]interface{}{
+			"start":      cbrIPString(r.start, r.bits),
+			"end":        cbrIPString(r.end, r.bits),
+			"ip_version": ipVersion,
+			"cidrs":      rangeCIDRs,
+		})
+		cidrs = append(cidrs, rangeCIDRs...)
+	}
+
+	d.SetId(*zone.ID)
+	if err = d.Set("name", zone.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("ip_ranges", ipRanges); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ip_ranges: %s", err))
+	}
+	if err = d.Set("cidrs", cidrs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting cidrs: %s", err))
+	}
+	if err = d.Set("ipv4_address_count", int(ipv4Count.Int64())); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting ipv4_address_count: %s", err))
+	}
+	if err = d.Set("vpc_crns", vpcCRNs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting vpc_crns: %s", err))
+	}
+	if err = d.Set("service_refs", serviceRefs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting service_refs: %s", err))
+	}
+
+	return nil
+}
+
+// cbrIPRange is an inclusive range of IP addresses of the same family, bits
+// is 32 for IPv4 and 128 for IPv6
+type cbrIPRange struct {
+	start *big.Int
+	end   *big.Int
+	bits  int
+}
+
+func (r cbrIPRange) size() *big.Int {
+	size := new(big.Int).Sub(r.end, r.start)
+	return size.Add(size, big.NewInt(1))
+}
+
+// cidrs splits the range in the largest aligned CIDR blocks
+func (r cbrIPRange) cidrs() []string {
+	cidrs := []string{}
+	one := big.NewInt(1)
+	start := new(big.Int).Set(r.start)
+	for start.Cmp(r.end) <= 0 {
+		prefix := r.bits
+		for prefix > 0 {
+			blockSize := new(big.Int).Lsh(one, uint(r.bits-prefix+1))
+			if new(big.Int).Mod(start, blockSize).Sign() != 0 {
+				break
+			}
+			last := new(big.Int).Add(start, blockSize)
+			if last.Sub(last, one).Cmp(r.end) > 0 {
+				break
+			}
+			prefix--
+		}
+		cidrs = append(cidrs, fmt.Sprintf("%s/%d", cbrIPString(start, r.bits), prefix))
+		start.Add(start, new(big.Int).Lsh(one, uint(r.bits-prefix)))
+	}
+	return cidrs
+}
+
+// parseCbrIPRange parses an address of type ipAddress, ipRange or subnet
+func parseCbrIPRange(addressType, value string) (cbrIPRange, error) {
+	switch addressType {
+	case contextbasedrestrictionsv1.AddressTypeIpaddressConst:
+		ip, bits, err := parseCbrIP(value)
+		if err != nil {
+			return cbrIPRange{}, err
+		}
+		return cbrIPRange{start: ip, end: ip, bits: bits}, nil
+	case contextbasedrestrictionsv1.AddressTypeIprangeConst:
+		parts := strings.SplitN(value, "-", 2)
+		if len(parts) != 2 {
+			return cbrIPRange{}, fmt.Errorf("invalid IP range %q", value)
+		}
+		start, bits, err := parseCbrIP(parts[0])
+		if err != nil {
+			return cbrIPRange{}, err
+		}
+		end, endBits, err := parseCbrIP(parts[1])
+		if err != nil {
+			return cbrIPRange{}, err
+		}
+		if bits != endBits || start.Cmp(end) > 0 {
+			return cbrIPRange{}, fmt.Errorf("invalid IP range %q", value)
+		}
+		return cbrIPRange{start: start, end: end, bits: bits}, nil
+	case contextbasedrestrictionsv1.AddressTypeSubnetConst:
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return cbrIPRange{}, err
+		}
+		ones, bits := network.Mask.Size()
+		start := new(big.Int).SetBytes(network.IP)
+		end := new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))
+		end.Add(end, start).Sub(end, big.NewInt(1))
+		return cbrIPRange{start: start, end: end, bits: bits}, nil
+	}
+	return cbrIPRange{}, fmt.Errorf("unsupported address type %q", addressType)
+}
+
+func parseCbrIP(value string) (*big.Int, int, error) {
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return nil, 0, fmt.Errorf("invalid IP address %q", value)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return new(big.Int).SetBytes(ip4), 32, nil
+	}
+	return new(big.Int).SetBytes(ip.To16()), 128, nil
+}
+
+func cbrIPString(ip *big.Int, bits int) string {
+	b := ip.Bytes()
+	buf := make([]byte, bits/8)
+	copy(buf[len(buf)-len(b):], b)
+	return net.IP(buf).String()
+}
+
+// mergeCbrIPRanges sorts the ranges, IPv4 first, and merges the ranges that
+// overlap or are adjacent
+func mergeCbrIPRanges(ranges []cbrIPRange) []cbrIPRange {
+	sorted := make([]cbrIPRange, len(ranges))
+	copy(sorted, ranges)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].bits != sorted[j].bits {
+			return sorted[i].bits < sorted[j].bits
+		}
+		return sorted[i].start.Cmp(sorted[j].start) < 0
+	})
+	merged := []cbrIPRange{}
+	for _, r := range sorted {
+		if n := len(merged); n > 0 && merged[n-1].bits == r.bits {
+			last := &merged[n-1]
+			next := new(big.Int).Add(last.end, big.NewInt(1))
+			if r.start.Cmp(next) <= 0 {
+				if r.end.Cmp(last.end) > 0 {
+					last.end = new(big.Int).Set(r.end)
+				}
+				continue
+			}
+		}
+		merged = append(merged, cbrIPRange{start: new(big.Int).Set(r.start), end: new(big.Int).Set(r.end), bits: r.bits})
+	}
+	return merged
+}
+
+// subtractCbrIPRanges removes the excluded ranges from the ranges, both are
+// merged and sorted
+func subtractCbrIPRanges(ranges, excluded []cbrIPRange) []cbrIPRange {
+	result := []cbrIPRange{}
+	for _, r := range ranges {
+		remaining := []cbrIPRange{r}
+		for _, e := range excluded {
+			if e.bits != r.bits {
+				continue
+			}
+			next := []cbrIPRange{}
+			for _, p := range remaining {
+				if e.end.Cmp(p.start) < 0 || e.start.Cmp(p.end) > 0 {
+					next = append(next, p)
+					continue
+				}
+				if e.start.Cmp(p.start) > 0 {
+					next = append(next, cbrIPRange{start: p.start, end: new(big.Int).Sub(e.start, big.NewInt(1)), bits: p.bits})
+				}
+				if e.end.Cmp(p.end) < 0 {
+					next = append(next, cbrIPRange{start: new(big.Int).Add(e.end, big.NewInt(1)), end: p.end, bits: p.bits})
+				}
+			}
+			remaining = next
+		}
+		result = append(result, remaining...)
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package contextbasedrestrictions_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCbrZoneAddressesDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCbrZoneAddressesDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "ip_ranges.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "ip_ranges.0.start", "169.23.22.0"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "ip_ranges.0.end", "169.23.22.9"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "ip_ranges.1.start", "169.23.22.11"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "ip_ranges.1.end", "169.23.23.255"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "ipv4_address_count", "511"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "cidrs.0", "169.23.22.0/29"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "service_refs.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone_addresses.cbr_zone_addresses", "service_refs.0.service_name", "cloud-object-storage"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrZoneAddressesDataSourceConfigBasic() string {
+	return `
+		data "ibm_iam_account_settings" "iam_account_settings" {
+		}
+
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "Test Zone Addresses Data Source Config Basic"
+			description = "Test Zone Addresses Data Source Config Basic"
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			addresses {
+				type = "subnet"
+				value = "169.23.23.0/24"
+			}
+			addresses {
+				type = "serviceRef"
+				ref {
+					account_id = data.ibm_iam_account_settings.iam_account_settings.account_id
+					service_name = "cloud-object-storage"
+				}
+			}
+			excluded {
+				type = "ipAddress"
+				value = "169.23.22.10"
+			}
+		}
+
+		data "ibm_cbr_zone_addresses" "cbr_zone_addresses" {
+			zone_id = ibm_cbr_zone.cbr_zone.id
+		}
+	`
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
@@ -14,6 +15,7 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
 )
@@ -26,6 +28,11 @@ func ResourceIBMCbrRule() *schema.Resource {
 		DeleteContext: resourceIBMCbrRuleDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -118,6 +125,71 @@ func ResourceIBMCbrRule() *schema.Resource {
 					},
 				},
 			},
+			"enforcement_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cbrEnforcementModeEnabled,
+				ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
+				Description:  "The rule enforcement mode: enabled, the rule is enforced; disabled, the rule isn't evaluated; report, the rule is evaluated and its decisions are reported to Activity Tracker only.",
+			},
+			"report_mode": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Applies an enabled rule in report mode first, when it's created, switched to enabled or its contexts or resources change. The requests the rule would have denied are searched in Activity Tracker over the window and the rule is enforced only when they stay within max_denials.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"window": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCbrRuleDuration,
+							Description:  "How long the rule stays in report mode before it is enforced, for example 30m. It has to be shorter than the create and update timeouts.",
+						},
+						"poll_interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1m",
+							ValidateFunc: validateCbrRuleDuration,
+							Description:  "How often Activity Tracker is searched for denials during the window.",
+						},
+						"activity_tracker_region": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The region of the Activity Tracker instance that receives the events of the account.",
+						},
+						"activity_tracker_endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public",
+							ValidateFunc: validate.InvokeValidator("ibm_cbr_rule", "activity_tracker_endpoint_type"),
+							Description:  "The endpoint used to search the Activity Tracker events, public or private.",
+						},
+						"activity_tracker_service_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "A service key of the Activity Tracker instance to search the events with.",
+						},
+						"query": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The Activity Tracker search query of the events of the rule, defaults to the rule ID. The events of the query with a Deny decision are counted as denials.",
+						},
+						"max_denials": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of denials tolerated during the window, the rule is left in report mode and the apply fails when there are more.",
+						},
+					},
+				},
+			},
+			"report_mode_denials": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of requests the rule would have denied during its last report window.",
+			},
 			"crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -168,6 +240,20 @@ func ResourceIBMCbrRuleValidator() *validate.ResourceValidator {
 			MinValueLength:             0,
 			MaxValueLength:             300,
 		},
+		validate.ValidateSchema{
+			Identifier:                 "enforcement_mode",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disabled, enabled, report",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "activity_tracker_endpoint_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "private, public",
+		},
 	)
 
 	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_cbr_rule", Schema: validateSchema}
@@ -210,14 +296,34 @@ func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, m
 		createRuleOptions.SetResources(resources)
 	}
 
-	rule, response, err := contextBasedRestrictionsClient.CreateRuleWithContext(context, createRuleOptions)
-	if err != nil {
+	// A rule that goes through report mode is created in report mode and
+	// enforced once the report window is over
+	enforcementMode := d.Get("enforcement_mode").(string)
+	reportFirst := cbrRuleNeedsReportMode(d)
+	if reportFirst {
+		if err = checkCbrRuleReportMode(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+		enforcementMode = cbrEnforcementModeReport
+	}
+
+	rule := &cbrRule{}
+	body := cbrRuleBody(createRuleOptions.Description, createRuleOptions.Contexts, createRuleOptions.Resources, enforcementMode)
+	response, err := cbrRuleRequest(context, contextBasedRestrictionsClient, core.POST, "/v1/rules", nil, "", body, rule)
+	if err != nil || rule.ID == nil {
 		log.Printf("[DEBUG] CreateRuleWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("CreateRuleWithContext failed %s\n%s", err, response))
 	}
 
 	d.SetId(*rule.ID)
 
+	if reportFirst {
+		if err = enforceCbrRuleAfterReport(context, d, meta, contextBasedRestrictionsClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			resourceIBMCbrRuleRead(context, d, meta)
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMCbrRuleRead(context, d, meta)
 }
 
@@ -312,11 +418,9 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
-	getRuleOptions := &contextbasedrestrictionsv1.GetRuleOptions{}
-
-	getRuleOptions.SetRuleID(d.Id())
-
-	rule, response, err := contextBasedRestrictionsClient.GetRuleWithContext(context, getRuleOptions)
+	ruleWithMode := &cbrRule{}
+	response, err := cbrRuleRequest(context, contextBasedRestrictionsClient, core.GET, "/v1/rules/{rule_id}", map[string]string{"rule_id": d.Id()}, "", nil, ruleWithMode)
+	rule := &ruleWithMode.Rule
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -349,6 +453,13 @@ func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, met
 			return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources: %s", err))
 		}
 	}
+	enforcementMode := cbrEnforcementModeEnabled
+	if ruleWithMode.EnforcementMode != nil {
+		enforcementMode = *ruleWithMode.EnforcementMode
+	}
+	if err = d.Set("enforcement_mode", enforcementMode); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting enforcement_mode: %s", err))
+	}
 	if err = d.Set("crn", rule.CRN); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
 	}
@@ -483,14 +594,31 @@ func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, m
 		replaceRuleOptions.SetResources(resources)
 	}
 
-	replaceRuleOptions.SetIfMatch(d.Get("version").(string))
+	enforcementMode := d.Get("enforcement_mode").(string)
+	reportFirst := cbrRuleNeedsReportMode(d)
+	if reportFirst {
+		if err = checkCbrRuleReportMode(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			// Nothing was sent, the rule keeps its previous state
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		enforcementMode = cbrEnforcementModeReport
+	}
 
-	_, response, err := contextBasedRestrictionsClient.ReplaceRuleWithContext(context, replaceRuleOptions)
+	body := cbrRuleBody(replaceRuleOptions.Description, replaceRuleOptions.Contexts, replaceRuleOptions.Resources, enforcementMode)
+	response, err := cbrRuleRequest(context, contextBasedRestrictionsClient, core.PUT, "/v1/rules/{rule_id}", map[string]string{"rule_id": d.Id()}, d.Get("version").(string), body, &cbrRule{})
 	if err != nil {
 		log.Printf("[DEBUG] ReplaceRuleWithContext failed %s\n%s", err, response)
 		return diag.FromErr(fmt.Errorf("ReplaceRuleWithContext failed %s\n%s", err, response))
 	}
 
+	if reportFirst {
+		if err = enforceCbrRuleAfterReport(context, d, meta, contextBasedRestrictionsClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			resourceIBMCbrRuleRead(context, d, meta)
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMCbrRuleRead(context, d, meta)
 }
 
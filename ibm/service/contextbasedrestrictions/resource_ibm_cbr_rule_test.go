@@ -69,6 +69,36 @@ func TestAccIBMCbrRuleAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCbrRuleEnforcementMode(t *testing.T) {
+	var conf contextbasedrestrictionsv1.Rule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCbrRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCbrRuleConfigEnforcementMode("report"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCbrRuleExists("ibm_cbr_rule.cbr_rule", conf),
+					resource.TestCheckResourceAttr("ibm_cbr_rule.cbr_rule", "enforcement_mode", "report"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCbrRuleConfigEnforcementMode("enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_rule.cbr_rule", "enforcement_mode", "enabled"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cbr_rule.cbr_rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCbrRuleConfigBasic() string {
 	return `
 		resource "ibm_cbr_rule" "cbr_rule" {
@@ -119,6 +149,28 @@ func testAccCheckIBMCbrRuleConfig(description string) string {
 	`, description)
 }
 
+func testAccCheckIBMCbrRuleConfigEnforcementMode(enforcementMode string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cbr_rule" "cbr_rule" {
+			description      = "test rule enforcement mode"
+			enforcement_mode = "%s"
+			contexts {
+    			attributes {
+      				name = "networkZoneId"
+      				value = "322af80e125f6842cded8ba7a1008370"
+    			}
+			}
+			resources {
+    			attributes {
+      				name = "serviceName"
+      				value = "user-management"
+    			}
+			}
+		}
+	`, enforcementMode)
+}
+
 func testAccCheckIBMCbrRuleExists(n string, obj contextbasedrestrictionsv1.Rule) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
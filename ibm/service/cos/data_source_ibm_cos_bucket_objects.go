@@ -0,0 +1,210 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMCosBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Limits the response to keys that begin with the specified prefix",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A character used to group keys. Keys that contain the delimiter after the prefix are rolled up into common_prefixes",
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Returns keys that come after the specified key in lexicographical order",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of keys to return. All matching keys are returned when not set",
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Number of keys requested from COS in each page of the listing",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of the object keys",
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of the key prefixes rolled up by the delimiter",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object MD5 hexdigest",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object size in bytes",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object storage class",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCosBucketObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error invalid COS bucket CRN (%s)", bucketCRN))
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listInput := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(bucketName),
+		MaxKeys: aws.Int64(int64(d.Get("page_size").(int))),
+	}
+	prefix := d.Get("prefix").(string)
+	if prefix != "" {
+		listInput.Prefix = aws.String(prefix)
+	}
+	delimiter := d.Get("delimiter").(string)
+	if delimiter != "" {
+		listInput.Delimiter = aws.String(delimiter)
+	}
+	startAfter := d.Get("start_after").(string)
+	if startAfter != "" {
+		listInput.StartAfter = aws.String(startAfter)
+	}
+	maxKeys := d.Get("max_keys").(int)
+
+	keys := make([]string, 0)
+	commonPrefixes := make([]string, 0)
+	objects := make([]map[string]interface{}, 0)
+
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, listInput, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+		for _, p := range page.CommonPrefixes {
+			commonPrefixes = append(commonPrefixes, aws.StringValue(p.Prefix))
+		}
+		for _, object := range page.Contents {
+			if maxKeys > 0 && len(keys) >= maxKeys {
+				return false
+			}
+			keys = append(keys, aws.StringValue(object.Key))
+			obj := map[string]interface{}{
+				"key":           aws.StringValue(object.Key),
+				"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+				"size":          int(aws.Int64Value(object.Size)),
+				"storage_class": aws.StringValue(object.StorageClass),
+			}
+			if object.LastModified != nil {
+				obj["last_modified"] = object.LastModified.Format(time.RFC1123)
+			}
+			objects = append(objects, obj)
+		}
+		if maxKeys > 0 && len(keys) >= maxKeys {
+			return false
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing objects in COS bucket (%s): %s", bucketName, err))
+	}
+
+	log.Printf("[INFO] Listed %d objects and %d common prefixes in COS bucket (%s)", len(keys), len(commonPrefixes), bucketName)
+
+	d.SetId(fmt.Sprintf("%s:objects:%s:location:%s", bucketCRN, prefix, bucketLocation))
+	if err := d.Set("keys", keys); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting keys: %s", err))
+	}
+	if err := d.Set("common_prefixes", commonPrefixes); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting common_prefixes: %s", err))
+	}
+	if err := d.Set("objects", objects); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting objects: %s", err))
+	}
+	return nil
+}
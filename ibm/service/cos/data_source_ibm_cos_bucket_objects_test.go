@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsDataSource_basic(t *testing.T) {
+	name := "tf-testacc-cos-objects"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsDataSourceConfig_basic(name, acc.CosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.testacc", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.0", "data/first.txt"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "common_prefixes.0", "data/nested/"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.testacc", "objects.0.etag"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_objects.testacc", "objects.0.last_modified"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "first" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "data/first.txt"
+			content         = "Acceptance testing"
+		}
+		resource "ibm_cos_bucket_object" "nested" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "data/nested/second.txt"
+			content         = "Acceptance testing"
+		}
+		data "ibm_cos_bucket_objects" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "data/"
+			delimiter       = "/"
+			depends_on      = [ibm_cos_bucket_object.first, ibm_cos_bucket_object.nested]
+		}`, name, crn)
+}
@@ -0,0 +1,540 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketDirectoryCreate,
+		ReadContext:   resourceIBMCOSBucketDirectoryRead,
+		UpdateContext: resourceIBMCOSBucketDirectoryUpdate,
+		DeleteContext: resourceIBMCOSBucketDirectoryDelete,
+		CustomizeDiff: resourceIBMCOSBucketDirectoryCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the local directory to upload",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix prepended to the key of every uploaded object",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types keyed by file extension, overriding the detected content type",
+			},
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Delete all versions of the uploaded objects when the directory is removed",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "MD5 hexdigest of the file of every object managed by the directory, keyed by object key",
+			},
+		},
+	}
+}
+
+// resourceIBMCOSBucketDirectoryCustomizeDiff hashes the local directory so that
+// the plan shows every added, changed and removed object key.
+func resourceIBMCOSBucketDirectoryCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("key_prefix") {
+		return diff.SetNewComputed("files")
+	}
+	source := diff.Get("source").(string)
+	keyPrefix := diff.Get("key_prefix").(string)
+
+	localFiles, err := listCOSDirectoryFiles(source, keyPrefix)
+	if err != nil {
+		return err
+	}
+	files := make(map[string]interface{}, len(localFiles))
+	for key, file := range localFiles {
+		files[key] = file.etag
+	}
+
+	old := diff.Get("files").(map[string]interface{})
+	if diff.Id() != "" && cosDirectoryFilesEqual(old, files) {
+		return nil
+	}
+	return diff.SetNew("files", files)
+}
+
+func resourceIBMCOSBucketDirectoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	localFiles, err := listCOSDirectoryFiles(d.Get("source").(string), keyPrefix)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// This check is to make sure new create does not
+	// overwrite objects that are not managed by Terraform
+	for _, key := range sortedCOSDirectoryKeys(localFiles) {
+		exists, err := objectExists(s3Client, bucketName, key)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if exists {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error COS bucket (%s) object (%s) already exists", bucketName, key))
+		}
+	}
+
+	d.SetId(getDirectoryId(bucketCRN, keyPrefix, bucketLocation))
+
+	uploaded := make(map[string]interface{}, len(localFiles))
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	for _, key := range sortedCOSDirectoryKeys(localFiles) {
+		if err := putCOSDirectoryFile(ctx, s3Client, bucketName, key, localFiles[key], contentTypes); err != nil {
+			// Record what has been uploaded so far so that a later apply can
+			// clean up or finish the sync.
+			d.Set("files", uploaded)
+			return diag.FromErr(err)
+		}
+		uploaded[key] = localFiles[key].etag
+	}
+	d.Set("files", uploaded)
+
+	return resourceIBMCOSBucketDirectoryRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := parseDirectoryId(d.Id(), "bucketCRN")
+	bucketName := parseDirectoryId(d.Id(), "bucketName")
+	instanceCRN := parseDirectoryId(d.Id(), "instanceCRN")
+	bucketLocation := parseDirectoryId(d.Id(), "bucketLocation")
+	keyPrefix := parseDirectoryId(d.Id(), "keyPrefix")
+	endpointType := d.Get("endpoint_type").(string)
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	d.Set("key_prefix", keyPrefix)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := listCOSDirectoryObjects(ctx, s3Client, bucketName, keyPrefix)
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			log.Printf("[WARN] COS bucket (%s) not found, removing directory from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// Only objects that were uploaded by this resource are tracked, so that
+	// objects written by other tools under the same prefix are left alone.
+	files := make(map[string]interface{})
+	for key, md5 := range d.Get("files").(map[string]interface{}) {
+		etag, ok := remote[key]
+		if !ok {
+			continue
+		}
+		// The ETag is only the MD5 of single part objects that are not
+		// encrypted with a key protect key, the MD5 of the uploaded file is
+		// also kept in the object metadata
+		if etag != md5.(string) {
+			etag, err = headCOSDirectoryObjectMD5(ctx, s3Client, bucketName, key, etag)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		files[key] = etag
+	}
+	d.Set("files", files)
+
+	return nil
+}
+
+func resourceIBMCOSBucketDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("files", "source", "content_types") {
+		bucketCRN := d.Get("bucket_crn").(string)
+		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+		bucketLocation := d.Get("bucket_location").(string)
+		endpointType := d.Get("endpoint_type").(string)
+		keyPrefix := d.Get("key_prefix").(string)
+
+		bxSession, err := m.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		localFiles, err := listCOSDirectoryFiles(d.Get("source").(string), keyPrefix)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		o, _ := d.GetChange("files")
+		current := o.(map[string]interface{})
+		contentTypes := d.Get("content_types").(map[string]interface{})
+		uploadAll := d.HasChange("content_types")
+
+		synced := make(map[string]interface{}, len(current))
+		for key, etag := range current {
+			synced[key] = etag
+		}
+
+		for _, key := range sortedCOSDirectoryKeys(localFiles) {
+			file := localFiles[key]
+			if etag, ok := current[key]; ok && etag.(string) == file.etag && !uploadAll {
+				continue
+			}
+			if err := putCOSDirectoryFile(ctx, s3Client, bucketName, key, file, contentTypes); err != nil {
+				d.Set("files", synced)
+				return diag.FromErr(err)
+			}
+			synced[key] = file.etag
+		}
+
+		for key := range current {
+			if _, ok := localFiles[key]; ok {
+				continue
+			}
+			if err := deleteCOSObjectVersion(s3Client, bucketName, key, "", false); err != nil {
+				d.Set("files", synced)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error deleting object (%s) from COS bucket (%s): %s", key, bucketName, err))
+			}
+			delete(synced, key)
+		}
+		d.Set("files", synced)
+	}
+
+	return resourceIBMCOSBucketDirectoryRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketDirectoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	forceDelete := d.Get("force_delete").(bool)
+	for key := range d.Get("files").(map[string]interface{}) {
+		if forceDelete {
+			err = deleteAllCOSObjectVersions(s3Client, bucketName, key, true, false)
+		} else {
+			err = deleteCOSObjectVersion(s3Client, bucketName, key, "", false)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+type cosDirectoryFile struct {
+	path string
+	etag string
+	size int64
+}
+
+// listCOSDirectoryFiles walks the source directory and returns its regular files
+// keyed by the object key they are uploaded to.
+func listCOSDirectoryFiles(source string, keyPrefix string) (map[string]cosDirectoryFile, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading COS directory source (%s): %s", source, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("[ERROR] Error COS directory source (%s) is not a directory", source)
+	}
+
+	files := make(map[string]cosDirectoryFile)
+	err = filepath.Walk(source, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		etag, err := fileMD5(p)
+		if err != nil {
+			return err
+		}
+		key := keyPrefix + filepath.ToSlash(rel)
+		files[key] = cosDirectoryFile{
+			path: p,
+			etag: etag,
+			size: fi.Size(),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error walking COS directory source (%s): %s", source, err)
+	}
+	return files, nil
+}
+
+// listCOSDirectoryObjects returns the etag of every object under the key prefix.
+func listCOSDirectoryObjects(ctx context.Context, s3Client *s3.S3, bucketName, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+	objects := make(map[string]string)
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	return objects, err
+}
+
+// cosDirectoryMD5Metadata is the object metadata that keeps the MD5 of the uploaded file
+const cosDirectoryMD5Metadata = "Md5"
+
+// headCOSDirectoryObjectMD5 returns the MD5 of the uploaded file from the
+// metadata of the object, or etag when the object has none
+func headCOSDirectoryObjectMD5(ctx context.Context, s3Client *s3.S3, bucketName, key, etag string) (string, error) {
+	out, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error reading object (%s) of COS bucket (%s): %s", key, bucketName, err)
+	}
+	for k, v := range out.Metadata {
+		if strings.EqualFold(k, cosDirectoryMD5Metadata) && aws.StringValue(v) != "" {
+			return aws.StringValue(v), nil
+		}
+	}
+	return etag, nil
+}
+
+func putCOSDirectoryFile(ctx context.Context, s3Client *s3.S3, bucketName, key string, file cosDirectoryFile, contentTypes map[string]interface{}) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", file.path, err)
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", file.path, err)
+		}
+	}()
+
+	contentType, err := detectCOSContentType(f, file.path, contentTypes)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error detecting content type of COS object file (%s): %s", file.path, err)
+	}
+
+	putInput := &s3.PutObjectInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		Body:        f,
+		ContentType: aws.String(contentType),
+		Metadata: map[string]*string{
+			cosDirectoryMD5Metadata: aws.String(file.etag),
+		},
+	}
+	log.Printf("[INFO] Uploading %s (%d bytes, %s) to COS bucket (%s) object (%s)", file.path, file.size, contentType, bucketName, key)
+	if _, err := s3Client.PutObjectWithContext(ctx, putInput); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+// detectCOSContentType resolves the content type from the user overrides, then
+// the file extension and finally by sniffing the first 512 bytes of the file.
+func detectCOSContentType(f io.ReadSeeker, filePath string, contentTypes map[string]interface{}) (string, error) {
+	ext := path.Ext(filePath)
+	for _, k := range []string{ext, strings.TrimPrefix(ext, ".")} {
+		if v, ok := contentTypes[k]; ok && k != "" {
+			return v.(string), nil
+		}
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := f.Read(buf)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(buf[:n]), nil
+}
+
+func fileMD5(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func sortedCOSDirectoryKeys(files map[string]cosDirectoryFile) []string {
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func cosDirectoryFilesEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, etag := range a {
+		if v, ok := b[key]; !ok || v != etag {
+			return false
+		}
+	}
+	return true
+}
+
+func getDirectoryId(bucketCRN string, keyPrefix string, bucketLocation string) string {
+	return fmt.Sprintf("%s:directory:%s:location:%s", bucketCRN, keyPrefix, bucketLocation)
+}
+
+func parseDirectoryId(id string, info string) string {
+	splitID := strings.Split(id, ":directory:")
+	bucketCRN := splitID[0]
+
+	if info == "instanceCRN" {
+		return fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	}
+
+	if info == "bucketCRN" {
+		return bucketCRN
+	}
+
+	if info == "bucketName" {
+		return strings.Split(bucketCRN, ":bucket:")[1]
+	}
+
+	location := strings.LastIndex(splitID[1], ":location:")
+	if info == "keyPrefix" {
+		return splitID[1][:location]
+	}
+
+	if info == "bucketLocation" {
+		return splitID[1][location+len(":location:"):]
+	}
+
+	return ""
+}
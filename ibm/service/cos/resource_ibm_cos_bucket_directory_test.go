@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketDirectory_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	source := "../../test-fixtures/cosDirectory"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketDirectoryConfig(name, instanceCRN, source, "site/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_directory.testacc", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory.testacc", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_directory.testacc", "files.site/css/site.css"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.testacc", "objects.0.key", "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketDirectoryConfig(name string, instanceCRN string, source string, keyPrefix string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_directory" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source          = "%[3]s"
+			key_prefix      = "%[4]s"
+		}
+		data "ibm_cos_bucket_objects" "testacc" {
+			bucket_crn      = ibm_cos_bucket_directory.testacc.bucket_crn
+			bucket_location = ibm_cos_bucket_directory.testacc.bucket_location
+			prefix          = ibm_cos_bucket_directory.testacc.key_prefix
+		}`, name, instanceCRN, source, keyPrefix)
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+func DataSourceIBMDatabaseBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DataSourceIBMDatabaseBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Deployment ID.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{clouddatabasesv5.BackupTypeOnDemandConst, clouddatabasesv5.BackupTypeScheduledConst}, false),
+				Description:  "Only list backups of this type.",
+			},
+			"backups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of this backup.",
+						},
+						"deployment_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the deployment this backup relates to.",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of backup.",
+						},
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of this backup.",
+						},
+						"is_downloadable": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Is this backup available to download?",
+						},
+						"is_restorable": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Can this backup be used to restore an instance?",
+						},
+						"download_link": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URI which is currently available for file downloading.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date and time when this backup was created.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMDatabaseBackupsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{}
+
+	listDeploymentBackupsOptions.SetID(d.Get("deployment_id").(string))
+
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListDeploymentBackupsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListDeploymentBackupsWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(d.Get("deployment_id").(string))
+
+	backupType := d.Get("type").(string)
+	backupList := make([]map[string]interface{}, 0, len(backups.Backups))
+	for _, backup := range backups.Backups {
+		if backupType != "" && (backup.Type == nil || *backup.Type != backupType) {
+			continue
+		}
+		backupList = append(backupList, dataSourceDatabaseBackupToMap(backup))
+	}
+
+	if err = d.Set("backups", backupList); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting backups: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceDatabaseBackupToMap(backup clouddatabasesv5.Backup) map[string]interface{} {
+	backupMap := map[string]interface{}{}
+	if backup.ID != nil {
+		backupMap["backup_id"] = *backup.ID
+	}
+	if backup.DeploymentID != nil {
+		backupMap["deployment_id"] = *backup.DeploymentID
+	}
+	if backup.Type != nil {
+		backupMap["type"] = *backup.Type
+	}
+	if backup.Status != nil {
+		backupMap["status"] = *backup.Status
+	}
+	if backup.IsDownloadable != nil {
+		backupMap["is_downloadable"] = *backup.IsDownloadable
+	}
+	if backup.IsRestorable != nil {
+		backupMap["is_restorable"] = *backup.IsRestorable
+	}
+	if backup.DownloadLink != nil {
+		backupMap["download_link"] = *backup.DownloadLink
+	}
+	backupMap["created_at"] = dateTimeToString(backup.CreatedAt)
+	return backupMap
+}
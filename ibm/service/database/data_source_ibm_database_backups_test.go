@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseBackupsDataSourceBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%s", acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseBackupsDataSourceConfigBasic(testName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_database_backups.backups", "deployment_id"),
+					resource.TestCheckResourceAttr("data.ibm_database_backups.backups", "backups.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_database_backups.backups", "backups.0.type", "on_demand"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_backups.backups", "backups.0.backup_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_backups.backups", "backups.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupsDataSourceConfigBasic(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+	}
+
+	resource "ibm_database_backup" "backup" {
+		deployment_id = ibm_database.db.id
+	}
+
+	data "ibm_database_backups" "backups" {
+		deployment_id = ibm_database_backup.backup.deployment_id
+		type          = "on_demand"
+	}
+				`, name, acc.IcdDbRegion)
+}
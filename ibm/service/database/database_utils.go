@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"fmt"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+// databaseTaskID returns the ID of the task started by a request
+func databaseTaskID(task *clouddatabasesv5.Task) (string, error) {
+	if task == nil || task.ID == nil {
+		return "", fmt.Errorf("[ERROR] The response has no task ID")
+	}
+	return *task.ID, nil
+}
+
+func boolValue(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func float64ToInt(f *float64) int {
+	if f == nil {
+		return 0
+	}
+	return int(*f)
+}
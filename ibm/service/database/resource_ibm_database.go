@@ -103,6 +103,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
+			resourceIBMDatabaseRestoreFromDiff,
 			checkV5Groups),
 
 		Importer: &schema.ResourceImporter{},
@@ -270,9 +271,45 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "public-and-private"}),
 			},
 			"backup_id": {
-				Description: "The CRN of backup source database",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:   "The CRN of backup source database",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"restore_from"},
+			},
+			"restore_from": {
+				Description:   "Restore the database from a backup or a point in time of a deployment",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"backup_id", "point_in_time_recovery_deployment_id", "point_in_time_recovery_time"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Description:  "The CRN of the backup to restore",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"restore_from.0.backup_id", "restore_from.0.point_in_time_recovery_deployment_id"},
+						},
+						"point_in_time_recovery_deployment_id": {
+							Description:  "The CRN of the deployment to restore at a point in time",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"restore_from.0.backup_id", "restore_from.0.point_in_time_recovery_deployment_id"},
+						},
+						"point_in_time_recovery_time": {
+							Description:  "The point in time to restore to, the earliest or latest recovery time is used when empty",
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"restore_from.0.point_in_time_recovery_deployment_id"},
+						},
+						"in_place": {
+							Description: "Replace an existing deployment with a deployment restored from the source when the source changes",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
 			},
 			"remote_leader_id": {
 				Description:      "The CRN of leader database",
@@ -310,12 +347,14 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
+				ConflictsWith:    []string{"restore_from"},
 			},
 			"point_in_time_recovery_time": {
 				Description:      "The point in time recovery time stamp of the deployed instance",
 				Type:             schema.TypeString,
 				Optional:         true,
 				DiffSuppressFunc: flex.ApplyOnce,
+				ConflictsWith:    []string{"restore_from"},
 			},
 			"users": {
 				Type:     schema.TypeSet,
@@ -437,6 +476,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			"whitelist": {
 				Type:     schema.TypeSet,
 				Optional: true,
+				// The entries are left to ibm_database_allowlist_entry when the block is not set
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address": {
@@ -652,172 +693,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 					},
 				},
 			},
-			"auto_scaling": {
-				Type:        schema.TypeList,
-				Description: "ICD Auto Scaling",
-				Optional:    true,
-				Computed:    true,
-				MaxItems:    1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"disk": {
-							Type:        schema.TypeList,
-							Description: "Disk Auto Scaling",
-							Optional:    true,
-							Computed:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"capacity_enabled": {
-										Description: "Auto Scaling Scalar: Capacity Enabled",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Computed:    true,
-									},
-									"free_space_less_than_percent": {
-										Description: "Auto Scaling Scalar: Capacity Free Space Less Than Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"io_enabled": {
-										Description: "Auto Scaling Scalar: IO Utilization Enabled",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Computed:    true,
-									},
-
-									"io_over_period": {
-										Description: "Auto Scaling Scalar: IO Utilization Over Period",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-									"io_above_percent": {
-										Description: "Auto Scaling Scalar: IO Utilization Above Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_increase_percent": {
-										Description: "Auto Scaling Rate: Increase Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_period_seconds": {
-										Description: "Auto Scaling Rate: Period Seconds",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_limit_mb_per_member": {
-										Description: "Auto Scaling Rate: Limit mb per member",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_units": {
-										Description: "Auto Scaling Rate: Units ",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-								},
-							},
-						},
-						"memory": {
-							Type:        schema.TypeList,
-							Description: "Memory Auto Scaling",
-							Optional:    true,
-							Computed:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"io_enabled": {
-										Description: "Auto Scaling Scalar: IO Utilization Enabled",
-										Type:        schema.TypeBool,
-										Optional:    true,
-										Computed:    true,
-									},
-
-									"io_over_period": {
-										Description: "Auto Scaling Scalar: IO Utilization Over Period",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-									"io_above_percent": {
-										Description: "Auto Scaling Scalar: IO Utilization Above Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_increase_percent": {
-										Description: "Auto Scaling Rate: Increase Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_period_seconds": {
-										Description: "Auto Scaling Rate: Period Seconds",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_limit_mb_per_member": {
-										Description: "Auto Scaling Rate: Limit mb per member",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_units": {
-										Description: "Auto Scaling Rate: Units ",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-								},
-							},
-						},
-						"cpu": {
-							Type:        schema.TypeList,
-							Description: "CPU Auto Scaling",
-							Optional:    true,
-							Computed:    true,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"rate_increase_percent": {
-										Description: "Auto Scaling Rate: Increase Percent",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_period_seconds": {
-										Description: "Auto Scaling Rate: Period Seconds",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_limit_count_per_member": {
-										Description: "Auto Scaling Rate: Limit count per number",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-									"rate_units": {
-										Description: "Auto Scaling Rate: Units ",
-										Type:        schema.TypeString,
-										Optional:    true,
-										Computed:    true,
-									},
-								},
-							},
-						},
-					},
-				},
-			},
+			"auto_scaling": databaseAutoScalingSchema(),
 			flex.ResourceName: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -849,6 +725,177 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 		},
 	}
 }
+
+// databaseAutoScalingSchema is the auto_scaling schema shared by ibm_database
+// and ibm_database_scaling_group
+func databaseAutoScalingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "ICD Auto Scaling",
+		Optional:    true,
+		Computed:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disk": {
+					Type:        schema.TypeList,
+					Description: "Disk Auto Scaling",
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"capacity_enabled": {
+								Description: "Auto Scaling Scalar: Capacity Enabled",
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Computed:    true,
+							},
+							"free_space_less_than_percent": {
+								Description: "Auto Scaling Scalar: Capacity Free Space Less Than Percent",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"io_enabled": {
+								Description: "Auto Scaling Scalar: IO Utilization Enabled",
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Computed:    true,
+							},
+
+							"io_over_period": {
+								Description: "Auto Scaling Scalar: IO Utilization Over Period",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+							},
+							"io_above_percent": {
+								Description: "Auto Scaling Scalar: IO Utilization Above Percent",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_increase_percent": {
+								Description: "Auto Scaling Rate: Increase Percent",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_period_seconds": {
+								Description: "Auto Scaling Rate: Period Seconds",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_limit_mb_per_member": {
+								Description: "Auto Scaling Rate: Limit mb per member",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_units": {
+								Description: "Auto Scaling Rate: Units ",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:        schema.TypeList,
+					Description: "Memory Auto Scaling",
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"io_enabled": {
+								Description: "Auto Scaling Scalar: IO Utilization Enabled",
+								Type:        schema.TypeBool,
+								Optional:    true,
+								Computed:    true,
+							},
+
+							"io_over_period": {
+								Description: "Auto Scaling Scalar: IO Utilization Over Period",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+							},
+							"io_above_percent": {
+								Description: "Auto Scaling Scalar: IO Utilization Above Percent",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_increase_percent": {
+								Description: "Auto Scaling Rate: Increase Percent",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_period_seconds": {
+								Description: "Auto Scaling Rate: Period Seconds",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_limit_mb_per_member": {
+								Description: "Auto Scaling Rate: Limit mb per member",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_units": {
+								Description: "Auto Scaling Rate: Units ",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+							},
+						},
+					},
+				},
+				"cpu": {
+					Type:        schema.TypeList,
+					Description: "CPU Auto Scaling",
+					Optional:    true,
+					Computed:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"rate_increase_percent": {
+								Description: "Auto Scaling Rate: Increase Percent",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_period_seconds": {
+								Description: "Auto Scaling Rate: Period Seconds",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_limit_count_per_member": {
+								Description: "Auto Scaling Rate: Limit count per number",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Computed:    true,
+							},
+							"rate_units": {
+								Description: "Auto Scaling Rate: Units ",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
 func ResourceIBMICDValidator() *validate.ResourceValidator {
 
 	validateSchema := make([]validate.ValidateSchema, 0)
@@ -1156,6 +1203,54 @@ func resourceIBMDatabaseInstanceDiff(_ context.Context, diff *schema.ResourceDif
 	return nil
 }
 
+// resourceIBMDatabaseRestoreFromDiff plans the in place restore of the deployment
+// when the restore_from source changes with in_place set. A new source without
+// in_place would never be restored, it is rejected.
+func resourceIBMDatabaseRestoreFromDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("restore_from") {
+		return nil
+	}
+
+	o, n := diff.GetChange("restore_from")
+	if !restoreFromSourceChanged(o, n) {
+		// Removing the restore source or toggling in_place does not affect the deployment
+		return nil
+	}
+	if !n.([]interface{})[0].(map[string]interface{})["in_place"].(bool) {
+		return fmt.Errorf("[ERROR] restore_from can only be set or changed on an existing deployment with in_place set to true, otherwise restore into a new ibm_database resource")
+	}
+
+	// The restored deployment replaces the deployment in the update
+	for _, k := range []string{"guid", flex.ResourceCRN, "connectionstrings"} {
+		if err := diff.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreFromSourceChanged reports whether the restore source of restore_from
+// changes from o to n, a removed source is no change
+func restoreFromSourceChanged(o, n interface{}) bool {
+	oldRestore := restoreFromSource(o.([]interface{}))
+	newRestore := restoreFromSource(n.([]interface{}))
+	return newRestore != nil && !reflect.DeepEqual(oldRestore, newRestore)
+}
+
+// restoreFromSource returns the restore source of a restore_from block without in_place
+func restoreFromSource(restoreFrom []interface{}) map[string]interface{} {
+	if len(restoreFrom) == 0 || restoreFrom[0] == nil {
+		return nil
+	}
+	source := map[string]interface{}{}
+	for k, v := range restoreFrom[0].(map[string]interface{}) {
+		if k != "in_place" {
+			source[k] = v
+		}
+	}
+	return source
+}
+
 // Replace with func wrapper for resourceIBMResourceInstanceCreate specifying serviceName := "database......."
 func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
@@ -1291,6 +1386,12 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 	if pitrTime, ok := d.GetOk("point_in_time_recovery_time"); ok {
 		params.PITRTimeStamp = pitrTime.(string)
 	}
+	if restoreFrom, ok := d.GetOk("restore_from"); ok && len(restoreFrom.([]interface{})) > 0 && restoreFrom.([]interface{})[0] != nil {
+		restore := restoreFrom.([]interface{})[0].(map[string]interface{})
+		params.BackupID = restore["backup_id"].(string)
+		params.PITRDeploymentID = restore["point_in_time_recovery_deployment_id"].(string)
+		params.PITRTimeStamp = restore["point_in_time_recovery_time"].(string)
+	}
 	serviceEndpoint := d.Get("service_endpoints").(string)
 	params.ServiceEndpoints = serviceEndpoint
 	parameters, _ := json.Marshal(params)
@@ -1746,6 +1847,10 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 }
 
 func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if o, n := d.GetChange("restore_from"); restoreFromSourceChanged(o, n) {
+		return resourceIBMDatabaseInstanceRestoreInPlace(context, d, meta)
+	}
+
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return diag.FromErr(err)
@@ -2339,6 +2444,52 @@ func getConnectionString(d *schema.ResourceData, userName, connectionEndpoint st
 	return csEntry, nil
 }
 
+// resourceIBMDatabaseInstanceRestoreInPlace restores the source of restore_from
+// in place of the deployment. ICD only restores into new deployments: the
+// restored deployment is created with the configuration of the resource, and
+// the deployment is deleted once the restored deployment is ready, so that a
+// backup of the deployment itself stays available during the restore.
+func resourceIBMDatabaseInstanceRestoreInPlace(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldID := d.Id()
+	diags := resourceIBMDatabaseInstanceCreate(context, d, meta)
+	newID := d.Id()
+	if diags.HasError() {
+		if newID != oldID {
+			log.Printf("[WARN] The restore of deployment (%s) into deployment (%s) failed, deployment (%s) is kept", oldID, newID, oldID)
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The restored deployment (%s) isn't managed by Terraform", newID),
+				Detail:   fmt.Sprintf("Deployment (%s) is kept, delete the restored deployment (%s) before applying again", oldID, newID),
+			})
+		}
+		d.SetId(oldID)
+		d.Partial(true)
+		return diags
+	}
+	log.Printf("[INFO] Restored deployment (%s) into deployment (%s), deleting deployment (%s)", oldID, newID, oldID)
+
+	// The creation only attached the tags that changed, the restored deployment
+	// gets all the tags of the resource
+	if tags, ok := d.GetOk("tags"); ok {
+		if err := flex.UpdateTagsUsingCRN(nil, tags, meta, d.Get(flex.ResourceCRN).(string)); err != nil {
+			log.Printf("Error on restore of ibm database (%s) tags: %s", newID, err)
+		}
+	}
+
+	d.SetId(oldID)
+	if deleteDiags := resourceIBMDatabaseInstanceDelete(context, d, meta); deleteDiags.HasError() {
+		d.SetId(newID)
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The deployment (%s) replaced by the restored deployment (%s) couldn't be deleted", oldID, newID),
+			Detail:   deleteDiags[0].Summary,
+		})
+	}
+	d.SetId(newID)
+
+	return diags
+}
+
 func resourceIBMDatabaseInstanceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -2535,6 +2686,52 @@ func waitForDatabaseTaskComplete(taskId string, d *schema.ResourceData, meta int
 	}
 }
 
+// waitForDatabaseTaskCompleteV5 polls a Cloud Databases task with the v5 client
+// until it completes, fails or the timeout is reached.
+func waitForDatabaseTaskCompleteV5(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, taskID string, timeout time.Duration) (*clouddatabasesv5.Task, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{databaseTaskProgressStatus, "queued"},
+		Target:  []string{databaseTaskSuccessStatus},
+		Refresh: func() (interface{}, string, error) {
+			getTaskOptions := &clouddatabasesv5.GetTaskOptions{
+				ID: &taskID,
+			}
+			getTaskResponse, response, err := cloudDatabasesClient.GetTaskWithContext(context, getTaskOptions)
+			if err != nil {
+				// Completed tasks are removed after a while
+				if response != nil && response.StatusCode == 404 {
+					return &clouddatabasesv5.Task{ID: &taskID}, databaseTaskSuccessStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] GetTask (%s) failed %s\n%s", taskID, err, response)
+			}
+			task := getTaskResponse.Task
+			if task == nil || task.Status == nil || *task.Status == "" {
+				return task, databaseTaskSuccessStatus, nil
+			}
+			if *task.Status == databaseTaskFailStatus {
+				description := ""
+				if task.Description != nil {
+					description = *task.Description
+				}
+				return task, *task.Status, fmt.Errorf("[ERROR] Database task (%s) failed: %s", taskID, description)
+			}
+			if task.ProgressPercent != nil {
+				log.Printf("[DEBUG] Database task (%s) is %s: %d%%", taskID, *task.Status, *task.ProgressPercent)
+			}
+			return task, *task.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	task, err := stateConf.WaitForStateContext(context)
+	if err != nil {
+		return nil, err
+	}
+	return task.(*clouddatabasesv5.Task), nil
+}
+
 func waitForDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
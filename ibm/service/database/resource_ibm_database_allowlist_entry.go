@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMDatabaseAllowlistEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseAllowlistEntryCreate,
+		ReadContext:   resourceIBMDatabaseAllowlistEntryRead,
+		DeleteContext: resourceIBMDatabaseAllowlistEntryDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Deployment ID.",
+			},
+			"address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateCIDR,
+				Description:  "Allowlist IP address in CIDR notation",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+				Description:  "Unique allowlist description",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseAllowlistEntryCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	address := d.Get("address").(string)
+
+	addAllowlistEntryOptions := &clouddatabasesv5.AddAllowlistEntryOptions{
+		ID: &deploymentID,
+		IPAddress: &clouddatabasesv5.AllowlistEntry{
+			Address:     &address,
+			Description: core.StringPtr(d.Get("description").(string)),
+		},
+	}
+
+	addAllowlistEntryResponse, response, err := cloudDatabasesClient.AddAllowlistEntryWithContext(context, addAllowlistEntryOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] AddAllowlistEntry (%s) failed %s\n%s", address, err, response))
+	}
+
+	taskID, err := databaseTaskID(addAllowlistEntryResponse.Task)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry (%s) create task to complete: %s", deploymentID, address, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/allowlists/ip_addresses/%s", deploymentID, address))
+
+	return resourceIBMDatabaseAllowlistEntryRead(context, d, meta)
+}
+
+func resourceIBMDatabaseAllowlistEntryRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getAllowlistOptions := &clouddatabasesv5.GetAllowlistOptions{
+		ID: &deploymentID,
+	}
+	allowlist, response, err := cloudDatabasesClient.GetAllowlistWithContext(context, getAllowlistOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database (%s) allowlist entry (%s) from state because the deployment is not found via the API", deploymentID, address)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetAllowlist (%s) failed %s\n%s", deploymentID, err, response))
+	}
+
+	var entry *clouddatabasesv5.AllowlistEntry
+	for i := range allowlist.IPAddresses {
+		if allowlist.IPAddresses[i].Address != nil && *allowlist.IPAddresses[i].Address == address {
+			entry = &allowlist.IPAddresses[i]
+			break
+		}
+	}
+	if entry == nil {
+		log.Printf("[WARN] Removing database (%s) allowlist entry (%s) from state because it's not found via the API", deploymentID, address)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("address", entry.Address)
+	d.Set("description", entry.Description)
+
+	return nil
+}
+
+func resourceIBMDatabaseAllowlistEntryDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID, address, err := parseDatabaseAllowlistEntryID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteAllowlistEntryOptions := &clouddatabasesv5.DeleteAllowlistEntryOptions{
+		ID:        &deploymentID,
+		Ipaddress: &address,
+	}
+
+	deleteAllowlistEntryResponse, response, err := cloudDatabasesClient.DeleteAllowlistEntryWithContext(context, deleteAllowlistEntryOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteAllowlistEntry (%s) failed %s\n%s", address, err, response))
+	}
+
+	taskID, err := databaseTaskID(deleteAllowlistEntryResponse.Task)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) allowlist entry (%s) delete task to complete: %s", deploymentID, address, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseDatabaseAllowlistEntryID splits an ID of the form <deployment_id>/allowlists/ip_addresses/<address>
+func parseDatabaseAllowlistEntryID(id string) (deploymentID, address string, err error) {
+	parts := strings.SplitN(id, "/allowlists/ip_addresses/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID/allowlists/ip_addresses/address", id)
+	}
+	return parts[0], parts[1], nil
+}
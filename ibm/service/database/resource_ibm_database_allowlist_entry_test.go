@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseAllowlistEntryBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_allowlist_entry.entry"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseAllowlistEntryConfig(testName, "172.168.1.2/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "deployment_id"),
+					resource.TestCheckResourceAttr(name, "address", "172.168.1.2/32"),
+					resource.TestCheckResourceAttr(name, "description", "desc1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseAllowlistEntryConfig(testName, "172.168.1.3/32"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "address", "172.168.1.3/32"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseAllowlistEntryConfig(name string, address string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+		adminpassword     = "password12"
+	}
+
+	resource "ibm_database_allowlist_entry" "entry" {
+		deployment_id = ibm_database.db.id
+		address       = "%[3]s"
+		description   = "desc1"
+	}
+				`, name, acc.IcdDbRegion, address)
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+func ResourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Deployment ID.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of this backup.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of backup.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of this backup.",
+			},
+			"is_downloadable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is this backup available to download?",
+			},
+			"is_restorable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Can this backup be used to restore an instance?",
+			},
+			"download_link": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URI which is currently available for file downloading.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when this backup was created.",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseBackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+
+	// The backup task doesn't reference the backup it creates, the backup is
+	// the on-demand backup that didn't exist before the task
+	existing, err := listDatabaseBackupIDs(context, cloudDatabasesClient, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	startOndemandBackupOptions := &clouddatabasesv5.StartOndemandBackupOptions{
+		ID: &deploymentID,
+	}
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackupWithContext(context, startOndemandBackupOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] StartOndemandBackup (%s) failed %s\n%s", deploymentID, err, response))
+	}
+
+	task := startOndemandBackupResponse.Task
+	_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, *task.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) backup task to complete: %s", deploymentID, err))
+	}
+
+	backups, err := listDatabaseBackupIDs(context, cloudDatabasesClient, deploymentID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	created := []string{}
+	for id, backupType := range backups {
+		if _, ok := existing[id]; !ok && backupType == clouddatabasesv5.BackupTypeOnDemandConst {
+			created = append(created, id)
+		}
+	}
+	switch len(created) {
+	case 0:
+		return diag.FromErr(fmt.Errorf("[ERROR] Error finding the on-demand backup of database (%s) created by task %s", deploymentID, *task.ID))
+	case 1:
+	default:
+		// Another on-demand backup ran at the same time, guessing could pick it
+		sort.Strings(created)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error finding the on-demand backup of database (%s) created by task %s: backups %s were created at the same time, import the backup of this resource", deploymentID, *task.ID, strings.Join(created, ", ")))
+	}
+
+	d.SetId(created[0])
+
+	return resourceIBMDatabaseBackupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupID := d.Id()
+	getBackupInfoOptions := &clouddatabasesv5.GetBackupInfoOptions{
+		BackupID: &backupID,
+	}
+	getBackupInfoResponse, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database backup (%s) from state because it's not found via the API", backupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupInfo (%s) failed %s\n%s", backupID, err, response))
+	}
+
+	backup := getBackupInfoResponse.Backup
+	d.Set("deployment_id", backup.DeploymentID)
+	d.Set("backup_id", backup.ID)
+	d.Set("type", backup.Type)
+	d.Set("status", backup.Status)
+	d.Set("is_downloadable", backup.IsDownloadable)
+	d.Set("is_restorable", backup.IsRestorable)
+	d.Set("download_link", backup.DownloadLink)
+	d.Set("created_at", dateTimeToString(backup.CreatedAt))
+
+	return nil
+}
+
+func resourceIBMDatabaseBackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// ICD does not allow deleting backups, they expire with the retention period of the deployment.
+	log.Printf("[WARN] Removing database backup (%s) from state, the backup is kept until it expires", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// listDatabaseBackupIDs returns the type of each backup of the deployment by backup ID
+func listDatabaseBackupIDs(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, deploymentID string) (map[string]string, error) {
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{
+		ID: &deploymentID,
+	}
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] ListDeploymentBackups (%s) failed %s\n%s", deploymentID, err, response)
+	}
+	ids := map[string]string{}
+	for _, b := range backups.Backups {
+		if b.ID != nil && b.Type != nil {
+			ids[*b.ID] = *b.Type
+		}
+	}
+	return ids, nil
+}
+
+func dateTimeToString(t *strfmt.DateTime) string {
+	if t == nil {
+		return ""
+	}
+	return t.String()
+}
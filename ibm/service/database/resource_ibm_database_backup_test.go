@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseBackupBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_backup.backup"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseBackupConfig(testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "deployment_id"),
+					resource.TestCheckResourceAttrSet(name, "backup_id"),
+					resource.TestCheckResourceAttr(name, "type", "on_demand"),
+					resource.TestCheckResourceAttr(name, "status", "completed"),
+					resource.TestCheckResourceAttr(name, "is_restorable", "true"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+					resource.TestCheckResourceAttrSet("ibm_database.restored", "id"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+		adminpassword     = "password12"
+	}
+
+	resource "ibm_database_backup" "backup" {
+		deployment_id = ibm_database.db.id
+	}
+
+	resource "ibm_database" "restored" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s-restored"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+		restore_from {
+			backup_id = ibm_database_backup.backup.backup_id
+		}
+	}
+				`, name, acc.IcdDbRegion)
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMDatabaseConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseConfigurationCreate,
+		ReadContext:   resourceIBMDatabaseConfigurationRead,
+		UpdateContext: resourceIBMDatabaseConfigurationUpdate,
+		DeleteContext: resourceIBMDatabaseConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Deployment ID.",
+			},
+			"configuration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					json, err := flex.NormalizeJSONString(v)
+					if err != nil {
+						return fmt.Sprintf("%q", err.Error())
+					}
+					return json
+				},
+				Description: "The configuration in JSON format",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+
+	err := updateDatabaseConfiguration(context, d, meta, deploymentID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(deploymentID)
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(d.Id()),
+	}
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database (%s) configuration from state because the deployment is not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDeploymentInfo (%s) failed %s\n%s", d.Id(), err, response))
+	}
+
+	// ICD does not return the applied configuration, it is populated from tf configuration.
+	d.Set("deployment_id", d.Id())
+
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("configuration") {
+		err := updateDatabaseConfiguration(context, d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMDatabaseConfigurationRead(context, d, meta)
+}
+
+func resourceIBMDatabaseConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The configuration of a deployment cannot be removed, only changed.
+	log.Printf("[WARN] Removing database (%s) configuration from state, the deployment keeps the last applied configuration", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func updateDatabaseConfiguration(context context.Context, d *schema.ResourceData, meta interface{}, deploymentID string, timeout time.Duration) error {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return err
+	}
+
+	configuration, err := expandDatabaseConfiguration(d.Get("configuration").(string))
+	if err != nil {
+		return err
+	}
+
+	updateDatabaseConfigurationOptions := &clouddatabasesv5.UpdateDatabaseConfigurationOptions{
+		ID:            &deploymentID,
+		Configuration: configuration,
+	}
+
+	updateDatabaseConfigurationResponse, response, err := cloudDatabasesClient.UpdateDatabaseConfigurationWithContext(context, updateDatabaseConfigurationOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] UpdateDatabaseConfiguration (%s) failed %s\n%s", deploymentID, err, response)
+	}
+
+	taskID, err := databaseTaskID(updateDatabaseConfigurationResponse.Task)
+	if err != nil {
+		return err
+	}
+	_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, timeout)
+	if err != nil {
+		return fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", deploymentID, err)
+	}
+	return nil
+}
+
+// expandDatabaseConfiguration decodes the JSON configuration and rejects the
+// settings that are not supported by any of the database types.
+func expandDatabaseConfiguration(s string) (*clouddatabasesv5.Configuration, error) {
+	configuration := &clouddatabasesv5.Configuration{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(s)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(configuration); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the database configuration: %s", err)
+	}
+	return configuration, nil
+}
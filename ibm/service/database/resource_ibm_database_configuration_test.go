@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseConfigurationBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_configuration.config"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseConfigurationConfig(testName, 200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "deployment_id"),
+					resource.TestCheckResourceAttr(name, "configuration", "{\"max_connections\":200}"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseConfigurationConfig(testName, 250),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "configuration", "{\"max_connections\":250}"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseConfigurationConfig(name string, maxConnections int) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+		adminpassword     = "password12"
+	}
+
+	resource "ibm_database_configuration" "config" {
+		deployment_id = ibm_database.db.id
+		configuration = jsonencode({
+			max_connections = %[3]d
+		})
+	}
+				`, name, acc.IcdDbRegion, maxConnections)
+}
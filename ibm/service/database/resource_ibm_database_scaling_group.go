@@ -0,0 +1,417 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMDatabaseScalingGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseScalingGroupCreate,
+		ReadContext:   resourceIBMDatabaseScalingGroupRead,
+		UpdateContext: resourceIBMDatabaseScalingGroupUpdate,
+		DeleteContext: resourceIBMDatabaseScalingGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Deployment ID.",
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "member",
+				ValidateFunc: validation.StringInSlice([]string{"member", "analytics", "bi_connector", "search"}, false),
+				Description:  "Scaling group name",
+			},
+			"members_allocation_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Allocated number of members",
+			},
+			"memory_allocation_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Allocated memory per member in MB",
+			},
+			"disk_allocation_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Allocated disk per member in MB",
+			},
+			"cpu_allocation_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Allocated dedicated CPUs per member",
+			},
+			"auto_scaling": databaseAutoScalingSchema(),
+		},
+	}
+}
+
+func resourceIBMDatabaseScalingGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+	groupID := d.Get("group_id").(string)
+
+	d.SetId(fmt.Sprintf("%s/groups/%s", deploymentID, groupID))
+
+	diags := resourceIBMDatabaseScalingGroupApply(context, d, meta, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+
+	return resourceIBMDatabaseScalingGroupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseScalingGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID, groupID, err := parseDatabaseScalingGroupID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listDeploymentScalingGroupsOptions := &clouddatabasesv5.ListDeploymentScalingGroupsOptions{
+		ID: &deploymentID,
+	}
+	groupsResponse, response, err := cloudDatabasesClient.ListDeploymentScalingGroupsWithContext(context, listDeploymentScalingGroupsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database (%s) scaling group (%s) from state because the deployment is not found via the API", deploymentID, groupID)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] ListDeploymentScalingGroups (%s) failed %s\n%s", deploymentID, err, response))
+	}
+
+	var group *clouddatabasesv5.Group
+	for i := range groupsResponse.Groups {
+		if groupsResponse.Groups[i].ID != nil && *groupsResponse.Groups[i].ID == groupID {
+			group = &groupsResponse.Groups[i]
+			break
+		}
+	}
+	if group == nil {
+		log.Printf("[WARN] Removing database (%s) scaling group (%s) from state because it's not found via the API", deploymentID, groupID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("group_id", groupID)
+
+	members := int64(1)
+	if group.Members != nil && group.Members.AllocationCount != nil && *group.Members.AllocationCount > 0 {
+		members = *group.Members.AllocationCount
+		d.Set("members_allocation_count", members)
+	}
+	if group.Memory != nil && group.Memory.AllocationMb != nil {
+		d.Set("memory_allocation_mb", *group.Memory.AllocationMb/members)
+	}
+	if group.Disk != nil && group.Disk.AllocationMb != nil {
+		d.Set("disk_allocation_mb", *group.Disk.AllocationMb/members)
+	}
+	if group.CPU != nil && group.CPU.AllocationCount != nil {
+		d.Set("cpu_allocation_count", *group.CPU.AllocationCount/members)
+	}
+
+	getAutoscalingConditionsOptions := &clouddatabasesv5.GetAutoscalingConditionsOptions{
+		ID:      &deploymentID,
+		GroupID: &groupID,
+	}
+	autoscalingGroup, response, err := cloudDatabasesClient.GetAutoscalingConditionsWithContext(context, getAutoscalingConditionsOptions)
+	if err != nil {
+		// Autoscaling is not available for every group of every database type
+		if response != nil && (response.StatusCode == 404 || response.StatusCode == 422) {
+			d.Set("auto_scaling", []interface{}{})
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetAutoscalingConditions (%s) failed %s\n%s", deploymentID, err, response))
+	}
+	d.Set("auto_scaling", flattenDatabaseScalingGroupAutoScaling(autoscalingGroup.Autoscaling))
+
+	return nil
+}
+
+func resourceIBMDatabaseScalingGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceIBMDatabaseScalingGroupApply(context, d, meta, d.Timeout(schema.TimeoutUpdate))
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceIBMDatabaseScalingGroupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseScalingGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Scaling groups are part of the deployment and cannot be removed.
+	log.Printf("[WARN] Removing database scaling group (%s) from state, the deployment keeps its current allocation", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMDatabaseScalingGroupApply(context context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID, groupID, err := parseDatabaseScalingGroupID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Allocations are configured per member, the API expects the totals.
+	members := int64(d.Get("members_allocation_count").(int))
+	if members == 0 {
+		members = 1
+	}
+
+	groupScaling := &clouddatabasesv5.GroupScaling{}
+	update := false
+	if d.HasChange("members_allocation_count") {
+		if v, ok := d.GetOk("members_allocation_count"); ok {
+			groupScaling.Members = &clouddatabasesv5.GroupScalingMembers{
+				AllocationCount: core.Int64Ptr(int64(v.(int))),
+			}
+			update = true
+		}
+	}
+	if d.HasChanges("memory_allocation_mb", "members_allocation_count") {
+		if v, ok := d.GetOk("memory_allocation_mb"); ok {
+			groupScaling.Memory = &clouddatabasesv5.GroupScalingMemory{
+				AllocationMb: core.Int64Ptr(int64(v.(int)) * members),
+			}
+			update = true
+		}
+	}
+	if d.HasChanges("disk_allocation_mb", "members_allocation_count") {
+		if v, ok := d.GetOk("disk_allocation_mb"); ok {
+			groupScaling.Disk = &clouddatabasesv5.GroupScalingDisk{
+				AllocationMb: core.Int64Ptr(int64(v.(int)) * members),
+			}
+			update = true
+		}
+	}
+	if d.HasChanges("cpu_allocation_count", "members_allocation_count") {
+		if v, ok := d.GetOk("cpu_allocation_count"); ok {
+			groupScaling.CPU = &clouddatabasesv5.GroupScalingCPU{
+				AllocationCount: core.Int64Ptr(int64(v.(int)) * members),
+			}
+			update = true
+		}
+	}
+
+	if update {
+		setDeploymentScalingGroupOptions := &clouddatabasesv5.SetDeploymentScalingGroupOptions{
+			ID:      &deploymentID,
+			GroupID: &groupID,
+			Group:   groupScaling,
+		}
+
+		setDeploymentScalingGroupResponse, response, err := cloudDatabasesClient.SetDeploymentScalingGroupWithContext(context, setDeploymentScalingGroupOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] SetDeploymentScalingGroup (%s) failed %s\n%s", groupID, err, response))
+		}
+
+		taskID, err := databaseTaskID(setDeploymentScalingGroupResponse.Task)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, timeout)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) scaling group (%s) update task to complete: %s", deploymentID, groupID, err))
+		}
+	}
+
+	if d.HasChange("auto_scaling") {
+		if _, ok := d.GetOk("auto_scaling.0"); ok {
+			setAutoscalingConditionsOptions := &clouddatabasesv5.SetAutoscalingConditionsOptions{
+				ID:          &deploymentID,
+				GroupID:     &groupID,
+				Autoscaling: expandDatabaseScalingGroupAutoScaling(d),
+			}
+
+			setAutoscalingConditionsResponse, response, err := cloudDatabasesClient.SetAutoscalingConditionsWithContext(context, setAutoscalingConditionsOptions)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] SetAutoscalingConditions (%s) failed %s\n%s", groupID, err, response))
+			}
+
+			taskID, err := databaseTaskID(setAutoscalingConditionsResponse.Task)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, timeout)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf(
+					"[ERROR] Error waiting for database (%s) scaling group (%s) auto_scaling update task to complete: %s", deploymentID, groupID, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func expandDatabaseScalingGroupAutoScaling(d *schema.ResourceData) *clouddatabasesv5.AutoscalingSetGroupAutoscaling {
+	autoscaling := &clouddatabasesv5.AutoscalingSetGroupAutoscaling{}
+
+	if v, ok := d.GetOk("auto_scaling.0.disk.0"); ok {
+		disk := v.(map[string]interface{})
+		autoscaling.Disk = &clouddatabasesv5.AutoscalingDiskGroupDisk{
+			Scalers: &clouddatabasesv5.AutoscalingDiskGroupDiskScalers{
+				Capacity: &clouddatabasesv5.AutoscalingDiskGroupDiskScalersCapacity{
+					Enabled:                  core.BoolPtr(disk["capacity_enabled"].(bool)),
+					FreeSpaceLessThanPercent: core.Int64Ptr(int64(disk["free_space_less_than_percent"].(int))),
+				},
+				IoUtilization: &clouddatabasesv5.AutoscalingDiskGroupDiskScalersIoUtilization{
+					Enabled:      core.BoolPtr(disk["io_enabled"].(bool)),
+					OverPeriod:   core.StringPtr(disk["io_over_period"].(string)),
+					AbovePercent: core.Int64Ptr(int64(disk["io_above_percent"].(int))),
+				},
+			},
+			Rate: &clouddatabasesv5.AutoscalingDiskGroupDiskRate{
+				IncreasePercent:  core.Float64Ptr(float64(disk["rate_increase_percent"].(int))),
+				PeriodSeconds:    core.Int64Ptr(int64(disk["rate_period_seconds"].(int))),
+				LimitMbPerMember: core.Float64Ptr(float64(disk["rate_limit_mb_per_member"].(int))),
+				Units:            core.StringPtr(disk["rate_units"].(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("auto_scaling.0.memory.0"); ok {
+		memory := v.(map[string]interface{})
+		autoscaling.Memory = &clouddatabasesv5.AutoscalingMemoryGroupMemory{
+			Scalers: &clouddatabasesv5.AutoscalingMemoryGroupMemoryScalers{
+				IoUtilization: &clouddatabasesv5.AutoscalingMemoryGroupMemoryScalersIoUtilization{
+					Enabled:      core.BoolPtr(memory["io_enabled"].(bool)),
+					OverPeriod:   core.StringPtr(memory["io_over_period"].(string)),
+					AbovePercent: core.Int64Ptr(int64(memory["io_above_percent"].(int))),
+				},
+			},
+			Rate: &clouddatabasesv5.AutoscalingMemoryGroupMemoryRate{
+				IncreasePercent:  core.Float64Ptr(float64(memory["rate_increase_percent"].(int))),
+				PeriodSeconds:    core.Int64Ptr(int64(memory["rate_period_seconds"].(int))),
+				LimitMbPerMember: core.Float64Ptr(float64(memory["rate_limit_mb_per_member"].(int))),
+				Units:            core.StringPtr(memory["rate_units"].(string)),
+			},
+		}
+	}
+
+	if v, ok := d.GetOk("auto_scaling.0.cpu.0"); ok {
+		cpu := v.(map[string]interface{})
+		autoscaling.CPU = &clouddatabasesv5.AutoscalingCPUGroupCPU{
+			Rate: &clouddatabasesv5.AutoscalingCPUGroupCPURate{
+				IncreasePercent:     core.Float64Ptr(float64(cpu["rate_increase_percent"].(int))),
+				PeriodSeconds:       core.Int64Ptr(int64(cpu["rate_period_seconds"].(int))),
+				LimitCountPerMember: core.Int64Ptr(int64(cpu["rate_limit_count_per_member"].(int))),
+				Units:               core.StringPtr(cpu["rate_units"].(string)),
+			},
+		}
+	}
+
+	return autoscaling
+}
+
+func flattenDatabaseScalingGroupAutoScaling(autoscaling *clouddatabasesv5.AutoscalingGroupAutoscaling) []map[string]interface{} {
+	if autoscaling == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{}
+
+	if autoscaling.Disk != nil {
+		disk := map[string]interface{}{}
+		if scalers := autoscaling.Disk.Scalers; scalers != nil {
+			if scalers.Capacity != nil {
+				disk["capacity_enabled"] = boolValue(scalers.Capacity.Enabled)
+				disk["free_space_less_than_percent"] = flex.IntValue(scalers.Capacity.FreeSpaceLessThanPercent)
+			}
+			if scalers.IoUtilization != nil {
+				disk["io_enabled"] = boolValue(scalers.IoUtilization.Enabled)
+				disk["io_over_period"] = stringValue(scalers.IoUtilization.OverPeriod)
+				disk["io_above_percent"] = flex.IntValue(scalers.IoUtilization.AbovePercent)
+			}
+		}
+		if rate := autoscaling.Disk.Rate; rate != nil {
+			disk["rate_increase_percent"] = float64ToInt(rate.IncreasePercent)
+			disk["rate_period_seconds"] = flex.IntValue(rate.PeriodSeconds)
+			disk["rate_limit_mb_per_member"] = float64ToInt(rate.LimitMbPerMember)
+			disk["rate_units"] = stringValue(rate.Units)
+		}
+		result["disk"] = []map[string]interface{}{disk}
+	}
+
+	if autoscaling.Memory != nil {
+		memory := map[string]interface{}{}
+		if scalers := autoscaling.Memory.Scalers; scalers != nil && scalers.IoUtilization != nil {
+			memory["io_enabled"] = boolValue(scalers.IoUtilization.Enabled)
+			memory["io_over_period"] = stringValue(scalers.IoUtilization.OverPeriod)
+			memory["io_above_percent"] = flex.IntValue(scalers.IoUtilization.AbovePercent)
+		}
+		if rate := autoscaling.Memory.Rate; rate != nil {
+			memory["rate_increase_percent"] = float64ToInt(rate.IncreasePercent)
+			memory["rate_period_seconds"] = flex.IntValue(rate.PeriodSeconds)
+			memory["rate_limit_mb_per_member"] = float64ToInt(rate.LimitMbPerMember)
+			memory["rate_units"] = stringValue(rate.Units)
+		}
+		result["memory"] = []map[string]interface{}{memory}
+	}
+
+	if autoscaling.CPU != nil {
+		cpu := map[string]interface{}{}
+		if rate := autoscaling.CPU.Rate; rate != nil {
+			cpu["rate_increase_percent"] = float64ToInt(rate.IncreasePercent)
+			cpu["rate_period_seconds"] = flex.IntValue(rate.PeriodSeconds)
+			cpu["rate_limit_count_per_member"] = flex.IntValue(rate.LimitCountPerMember)
+			cpu["rate_units"] = stringValue(rate.Units)
+		}
+		result["cpu"] = []map[string]interface{}{cpu}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+// parseDatabaseScalingGroupID splits an ID of the form <deployment_id>/groups/<group_id>
+func parseDatabaseScalingGroupID(id string) (deploymentID, groupID string, err error) {
+	parts := strings.SplitN(id, "/groups/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID/groups/groupID", id)
+	}
+	return parts[0], parts[1], nil
+}
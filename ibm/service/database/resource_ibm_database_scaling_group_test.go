@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseScalingGroupBasic(t *testing.T) {
+	testName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	name := "ibm_database_scaling_group.member"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseScalingGroupConfig(testName, 2048),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "deployment_id"),
+					resource.TestCheckResourceAttr(name, "group_id", "member"),
+					resource.TestCheckResourceAttr(name, "members_allocation_count", "2"),
+					resource.TestCheckResourceAttr(name, "memory_allocation_mb", "2048"),
+					resource.TestCheckResourceAttr(name, "auto_scaling.0.disk.0.capacity_enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseScalingGroupConfig(testName, 3072),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "memory_allocation_mb", "3072"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseScalingGroupConfig(name string, memory int) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		is_default = true
+	}
+
+	resource "ibm_database" "db" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[1]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[2]s"
+		adminpassword     = "password12"
+	}
+
+	resource "ibm_database_scaling_group" "member" {
+		deployment_id        = ibm_database.db.id
+		group_id             = "member"
+		memory_allocation_mb = %[3]d
+		auto_scaling {
+			disk {
+				capacity_enabled             = true
+				free_space_less_than_percent = 15
+				rate_increase_percent        = 20
+				rate_period_seconds          = 900
+				rate_units                   = "mb"
+			}
+		}
+	}
+				`, name, acc.IcdDbRegion, memory)
+}
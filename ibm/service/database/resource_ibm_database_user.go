@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Deployment ID.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 32),
+				Description:  "User name",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(10, 32),
+				Description:  "User password",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+				Description:  "User type",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"group_read_only", "group_data_access_admin"}, false),
+				Description:  "User role. Only available for ops_manager user type.",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	userType := d.Get("type").(string)
+	userName := d.Get("name").(string)
+
+	user := &clouddatabasesv5.User{
+		Username: core.StringPtr(userName),
+		Password: core.StringPtr(d.Get("password").(string)),
+	}
+	if role, ok := d.GetOk("role"); ok {
+		// User Role only for ops_manager user type
+		if userType != "ops_manager" {
+			return diag.FromErr(fmt.Errorf("[ERROR] role is only supported for the ops_manager user type"))
+		}
+		user.Role = core.StringPtr(role.(string))
+	}
+
+	createDatabaseUserOptions := &clouddatabasesv5.CreateDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		User:     user,
+	}
+
+	createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUserWithContext(context, createDatabaseUserOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed %s\n%s", userName, err, response))
+	}
+
+	taskID, err := databaseTaskID(createDatabaseUserResponse.Task)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) create task to complete: %s", deploymentID, userName, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/users/%s/%s", deploymentID, userType, userName))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID, userType, userName, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// ICD does not implement a GetUser API, the user exists when a connection
+	// can be composed for it on one of the deployment endpoints. A deployment
+	// may only have one of the endpoints, so the user is only gone when none
+	// of them knows it.
+	found := false
+	notFound := 0
+	var lastErr error
+	endpointTypes := []string{"public", "private"}
+	for _, endpointType := range endpointTypes {
+		endpointType := endpointType
+		getConnectionOptions := &clouddatabasesv5.GetConnectionOptions{
+			ID:           &deploymentID,
+			UserType:     &userType,
+			UserID:       &userName,
+			EndpointType: &endpointType,
+		}
+		_, response, err := cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
+		if err == nil {
+			found = true
+			break
+		}
+		if response != nil && response.StatusCode == 404 {
+			notFound++
+			continue
+		}
+		lastErr = fmt.Errorf("[ERROR] GetConnection (%s) failed %s\n%s", userName, err, response)
+	}
+	if !found {
+		if notFound == len(endpointTypes) {
+			log.Printf("[WARN] Removing database (%s) user (%s) from state because it's not found via the API", deploymentID, userName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(lastErr)
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", userName)
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("password") {
+		cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		deploymentID, userType, userName, err := parseDatabaseUserID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		changeUserPasswordOptions := &clouddatabasesv5.ChangeUserPasswordOptions{
+			ID:       &deploymentID,
+			UserType: &userType,
+			Username: &userName,
+			User: &clouddatabasesv5.APasswordSettingUser{
+				Password: core.StringPtr(d.Get("password").(string)),
+			},
+		}
+
+		changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPasswordWithContext(context, changeUserPasswordOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", userName, err, response))
+		}
+
+		taskID, err := databaseTaskID(changeUserPasswordResponse.Task)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) user (%s) password update task to complete: %s", deploymentID, userName, err))
+		}
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deploymentID, userType, userName, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDatabaseUserOptions := &clouddatabasesv5.DeleteDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		Username: &userName,
+	}
+
+	deleteDatabaseUserResponse, response, err := cloudDatabasesClient.DeleteDatabaseUserWithContext(context, deleteDatabaseUserOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteDatabaseUser (%s) failed %s\n%s", userName, err, response))
+	}
+
+	taskID, err := databaseTaskID(deleteDatabaseUserResponse.Task)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = waitForDatabaseTaskCompleteV5(context, cloudDatabasesClient, taskID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) delete task to complete: %s", deploymentID, userName, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseDatabaseUserID splits an ID of the form <deployment_id>/users/<type>/<name>
+func parseDatabaseUserID(id string) (deploymentID, userType, userName string, err error) {
+	parts := strings.SplitN(id, "/users/", 2)
+	if len(parts) != 2 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID/users/userType/userName", id)
+	}
+	userParts := strings.SplitN(parts[1], "/", 2)
+	if len(userParts) != 2 || userParts[0] == "" || userParts[1] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of deploymentID/users/userType/userName", id)
+	}
+	return parts[0], userParts[0], userParts[1], nil
+}
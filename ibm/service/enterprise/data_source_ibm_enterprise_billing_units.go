@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+)
+
+func DataSourceIBMEnterpriseBillingUnits() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseBillingUnitsRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The enterprise ID to list the billing units of.",
+			},
+			"account_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The account group ID to list the billing units of.",
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The enterprise account ID to list the billing units of.",
+			},
+			"billing_units": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of billing units.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the billing unit, which is a globally unique identifier (GUID).",
+						},
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the billing unit.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the billing unit.",
+						},
+						"enterprise_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the enterprise to which the billing unit is associated.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency code for the billing unit.",
+						},
+						"country_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The country code for the billing unit.",
+						},
+						"master": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "A flag that indicates whether this billing unit is the primary billing mechanism for the enterprise.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The creation date of the billing unit.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseBillingUnitsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseBillingUnitsClient, err := meta.(conns.ClientSession).EnterpriseBillingUnitsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listBillingUnitsOptions := &enterprisebillingunitsv1.ListBillingUnitsOptions{}
+	if v, ok := d.GetOk("enterprise_id"); ok {
+		listBillingUnitsOptions.SetEnterpriseID(v.(string))
+	}
+	if v, ok := d.GetOk("account_group_id"); ok {
+		listBillingUnitsOptions.SetAccountGroupID(v.(string))
+	}
+	if v, ok := d.GetOk("account_id"); ok {
+		listBillingUnitsOptions.SetAccountID(v.(string))
+	}
+
+	billingUnitsList, response, err := enterpriseBillingUnitsClient.ListBillingUnitsWithContext(context, listBillingUnitsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListBillingUnitsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing billing units: %s\n%s", err, response))
+	}
+
+	d.SetId(dataSourceIbmEnterpriseBillingUnitsID(d))
+
+	if err = d.Set("billing_units", dataSourceEnterpriseBillingUnitsFlatten(billingUnitsList.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting billing_units %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIbmEnterpriseBillingUnitsID returns a reasonable ID for the list.
+func dataSourceIbmEnterpriseBillingUnitsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceEnterpriseBillingUnitsFlatten(result []enterprisebillingunitsv1.BillingUnit) (billingUnits []map[string]interface{}) {
+	billingUnits = []map[string]interface{}{}
+	for _, billingUnit := range result {
+		billingUnitMap := map[string]interface{}{}
+		if billingUnit.ID != nil {
+			billingUnitMap["id"] = billingUnit.ID
+		}
+		if billingUnit.CRN != nil {
+			billingUnitMap["crn"] = billingUnit.CRN
+		}
+		if billingUnit.Name != nil {
+			billingUnitMap["name"] = billingUnit.Name
+		}
+		if billingUnit.EnterpriseID != nil {
+			billingUnitMap["enterprise_id"] = billingUnit.EnterpriseID
+		}
+		if billingUnit.CurrencyCode != nil {
+			billingUnitMap["currency_code"] = billingUnit.CurrencyCode
+		}
+		if billingUnit.CountryCode != nil {
+			billingUnitMap["country_code"] = billingUnit.CountryCode
+		}
+		if billingUnit.Master != nil {
+			billingUnitMap["master"] = billingUnit.Master
+		}
+		if billingUnit.CreatedAt != nil {
+			billingUnitMap["created_at"] = billingUnit.CreatedAt.String()
+		}
+		billingUnits = append(billingUnits, billingUnitMap)
+	}
+	return billingUnits
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+)
+
+func DataSourceIBMEnterpriseCreditPools() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseCreditPoolsRead,
+
+		Schema: map[string]*schema.Schema{
+			"billing_unit_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the billing unit.",
+			},
+			"date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The date in the format of YYYY-MM.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PLATFORM", "SUPPORT"}, false),
+				Description:  "Filters the credit pools by type, either PLATFORM or SUPPORT.",
+			},
+			"credit_pools": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of credit pools.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of credit, either PLATFORM or SUPPORT.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency code of the associated billing unit.",
+						},
+						"billing_unit_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the billing unit.",
+						},
+						"overage_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The number of credits used as overage.",
+						},
+						"term_credits": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "A list of active subscription terms available within a credit pool.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"billing_option_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the billing option from which the subscription term is derived.",
+									},
+									"category": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The category of the billing option, e.g. PLATFORM, SERVICE or SUPPORT.",
+									},
+									"start_date": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The start date of the term.",
+									},
+									"end_date": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The end date of the term.",
+									},
+									"total_credits": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The total credit available in this term.",
+									},
+									"starting_balance": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The balance of available credit at the start of the current month.",
+									},
+									"used_credits": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The amount of credit used during the current month.",
+									},
+									"current_balance": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The balance of remaining credit in the subscription term.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseCreditPoolsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseBillingUnitsClient, err := meta.(conns.ClientSession).EnterpriseBillingUnitsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getCreditPoolsOptions := enterpriseBillingUnitsClient.NewGetCreditPoolsOptions(d.Get("billing_unit_id").(string))
+	if v, ok := d.GetOk("date"); ok {
+		getCreditPoolsOptions.SetDate(v.(string))
+	}
+	if v, ok := d.GetOk("type"); ok {
+		getCreditPoolsOptions.SetType(v.(string))
+	}
+
+	creditPoolsList, response, err := enterpriseBillingUnitsClient.GetCreditPoolsWithContext(context, getCreditPoolsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetCreditPoolsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting credit pools: %s\n%s", err, response))
+	}
+
+	d.SetId(dataSourceIbmEnterpriseCreditPoolsID(d))
+
+	if err = d.Set("credit_pools", dataSourceEnterpriseCreditPoolsFlatten(creditPoolsList.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting credit_pools %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIbmEnterpriseCreditPoolsID returns a reasonable ID for the list.
+func dataSourceIbmEnterpriseCreditPoolsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceEnterpriseCreditPoolsFlatten(result []enterprisebillingunitsv1.CreditPool) (creditPools []map[string]interface{}) {
+	creditPools = []map[string]interface{}{}
+	for _, creditPool := range result {
+		creditPoolMap := map[string]interface{}{}
+		if creditPool.Type != nil {
+			creditPoolMap["type"] = creditPool.Type
+		}
+		if creditPool.CurrencyCode != nil {
+			creditPoolMap["currency_code"] = creditPool.CurrencyCode
+		}
+		if creditPool.BillingUnitID != nil {
+			creditPoolMap["billing_unit_id"] = creditPool.BillingUnitID
+		}
+		if creditPool.Overage != nil && creditPool.Overage.Cost != nil {
+			creditPoolMap["overage_cost"] = creditPool.Overage.Cost
+		}
+		termCredits := []map[string]interface{}{}
+		for _, termCredit := range creditPool.TermCredits {
+			termCreditMap := map[string]interface{}{}
+			if termCredit.BillingOptionID != nil {
+				termCreditMap["billing_option_id"] = termCredit.BillingOptionID
+			}
+			if termCredit.Category != nil {
+				termCreditMap["category"] = termCredit.Category
+			}
+			if termCredit.StartDate != nil {
+				termCreditMap["start_date"] = termCredit.StartDate.String()
+			}
+			if termCredit.EndDate != nil {
+				termCreditMap["end_date"] = termCredit.EndDate.String()
+			}
+			if termCredit.TotalCredits != nil {
+				termCreditMap["total_credits"] = termCredit.TotalCredits
+			}
+			if termCredit.StartingBalance != nil {
+				termCreditMap["starting_balance"] = termCredit.StartingBalance
+			}
+			if termCredit.UsedCredits != nil {
+				termCreditMap["used_credits"] = termCredit.UsedCredits
+			}
+			if termCredit.CurrentBalance != nil {
+				termCreditMap["current_balance"] = termCredit.CurrentBalance
+			}
+			termCredits = append(termCredits, termCreditMap)
+		}
+		creditPoolMap["term_credits"] = termCredits
+		creditPools = append(creditPools, creditPoolMap)
+	}
+	return creditPools
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package enterprise_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseCreditPoolsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseCreditPoolsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_credit_pools.credit_pools", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_credit_pools.credit_pools", "credit_pools.#"),
+					resource.TestCheckResourceAttr("data.ibm_enterprise_credit_pools.credit_pools", "credit_pools.0.type", "PLATFORM"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmEnterpriseCreditPoolsDataSourceConfigBasic() string {
+	return `
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		data "ibm_enterprise_billing_units" "billing_units" {
+			enterprise_id = data.ibm_enterprises.enterprises_instance.enterprises[0].id
+		}
+		data "ibm_enterprise_credit_pools" "credit_pools" {
+			billing_unit_id = data.ibm_enterprise_billing_units.billing_units.billing_units[0].id
+			type            = "PLATFORM"
+		}
+	`
+}
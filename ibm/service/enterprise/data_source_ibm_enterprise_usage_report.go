@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package enterprise
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+)
+
+func DataSourceIBMEnterpriseUsageReport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmEnterpriseUsageReportRead,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The ID of the enterprise for which the reports are queried.",
+			},
+			"account_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The ID of the account group for which the reports are queried.",
+			},
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"enterprise_id", "account_group_id", "account_id"},
+				Description:  "The ID of the account for which the reports are queried.",
+			},
+			"children": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Returns the reports for the immediate child entities (account groups and accounts) of the entity instead of the entity itself.",
+			},
+			"month": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The billing month for which the usage report is requested, in the format of YYYY-MM. Defaults to the current month.",
+			},
+			"billing_unit_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the billing unit by which to filter the reports.",
+			},
+			"reports": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage reports.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the entity.",
+						},
+						"entity_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the entity, e.g. enterprise, account-group or account.",
+						},
+						"entity_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Cloud Resource Name (CRN) of the entity.",
+						},
+						"entity_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A user-defined name for the entity.",
+						},
+						"billing_unit_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the billing unit.",
+						},
+						"billing_unit_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the billing unit.",
+						},
+						"billing_unit_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the billing unit.",
+						},
+						"country_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The country code of the billing unit.",
+						},
+						"currency_code": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The currency code of the billing unit.",
+						},
+						"month": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The billing month of the report.",
+						},
+						"billable_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable charges for all cloud resources used by the entity.",
+						},
+						"non_billable_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The non-billable charges for all cloud resources used by the entity.",
+						},
+						"billable_rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The billable charges before discounts for all cloud resources used by the entity.",
+						},
+						"non_billable_rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The non-billable charges before discounts for all cloud resources used by the entity.",
+						},
+						"resources": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The usage of the resources of the entity.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the resource.",
+									},
+									"billable_cost": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The billable charges for the resource.",
+									},
+									"non_billable_cost": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The non-billable charges for the resource.",
+									},
+									"billable_rated_cost": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The billable charges before discounts for the resource.",
+									},
+									"non_billable_rated_cost": {
+										Type:        schema.TypeFloat,
+										Computed:    true,
+										Description: "The non-billable charges before discounts for the resource.",
+									},
+									"plans": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The usage of the plans of the resource.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"plan_id": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The ID of the plan.",
+												},
+												"pricing_region": {
+													Type:        schema.TypeString,
+													Computed:    true,
+													Description: "The pricing region of the plan.",
+												},
+												"billable": {
+													Type:        schema.TypeBool,
+													Computed:    true,
+													Description: "Whether the plan charges are billed to the customer.",
+												},
+												"cost": {
+													Type:        schema.TypeFloat,
+													Computed:    true,
+													Description: "The total cost incurred by the plan.",
+												},
+												"rated_cost": {
+													Type:        schema.TypeFloat,
+													Computed:    true,
+													Description: "The total pre-discounted cost incurred by the plan.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmEnterpriseUsageReportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseUsageReportsClient, err := meta.(conns.ClientSession).EnterpriseUsageReportsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	offset := ""
+	var allRecs []enterpriseusagereportsv1.ResourceUsageReport
+	for {
+		getResourceUsageReportOptions := &enterpriseusagereportsv1.GetResourceUsageReportOptions{}
+		if v, ok := d.GetOk("enterprise_id"); ok {
+			getResourceUsageReportOptions.SetEnterpriseID(v.(string))
+		}
+		if v, ok := d.GetOk("account_group_id"); ok {
+			getResourceUsageReportOptions.SetAccountGroupID(v.(string))
+		}
+		if v, ok := d.GetOk("account_id"); ok {
+			getResourceUsageReportOptions.SetAccountID(v.(string))
+		}
+		getResourceUsageReportOptions.SetChildren(d.Get("children").(bool))
+		if v, ok := d.GetOk("month"); ok {
+			getResourceUsageReportOptions.SetMonth(v.(string))
+		}
+		if v, ok := d.GetOk("billing_unit_id"); ok {
+			getResourceUsageReportOptions.SetBillingUnitID(v.(string))
+		}
+		if offset != "" {
+			getResourceUsageReportOptions.SetOffset(offset)
+		}
+
+		reports, response, err := enterpriseUsageReportsClient.GetResourceUsageReportWithContext(context, getResourceUsageReportOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetResourceUsageReportWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting usage report: %s\n%s", err, response))
+		}
+		allRecs = append(allRecs, reports.Reports...)
+
+		offset = ""
+		if reports.Next != nil && reports.Next.Href != nil {
+			next, err := url.Parse(*reports.Next.Href)
+			if err != nil {
+				log.Printf("[DEBUG] GetResourceUsageReportWithContext failed. Error occurred while parsing next link: %s", err)
+				return diag.FromErr(err)
+			}
+			offset = next.Query().Get("offset")
+		}
+		if offset == "" {
+			break
+		}
+	}
+
+	d.SetId(dataSourceIbmEnterpriseUsageReportID(d))
+
+	if err = d.Set("reports", dataSourceEnterpriseUsageReportFlatten(allRecs)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting reports %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIbmEnterpriseUsageReportID returns a reasonable ID for the list.
+func dataSourceIbmEnterpriseUsageReportID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func dataSourceEnterpriseUsageReportFlatten(result []enterpriseusagereportsv1.ResourceUsageReport) (reports []map[string]interface{}) {
+	reports = []map[string]interface{}{}
+	for _, report := range result {
+		resources := []map[string]interface{}{}
+		for _, resource := range report.Resources {
+			plans := []map[string]interface{}{}
+			for _, plan := range resource.Plans {
+				planMap := map[string]interface{}{
+					"plan_id":    plan.PlanID,
+					"billable":   plan.Billable,
+					"cost":       plan.Cost,
+					"rated_cost": plan.RatedCost,
+				}
+				if plan.PricingRegion != nil {
+					planMap["pricing_region"] = plan.PricingRegion
+				}
+				plans = append(plans, planMap)
+			}
+			resources = append(resources, map[string]interface{}{
+				"resource_id":             resource.ResourceID,
+				"billable_cost":           resource.BillableCost,
+				"non_billable_cost":       resource.NonBillableCost,
+				"billable_rated_cost":     resource.BillableRatedCost,
+				"non_billable_rated_cost": resource.NonBillableRatedCost,
+				"plans":                   plans,
+			})
+		}
+		reports = append(reports, map[string]interface{}{
+			"entity_id":               report.EntityID,
+			"entity_type":             report.EntityType,
+			"entity_crn":              report.EntityCRN,
+			"entity_name":             report.EntityName,
+			"billing_unit_id":         report.BillingUnitID,
+			"billing_unit_crn":        report.BillingUnitCRN,
+			"billing_unit_name":       report.BillingUnitName,
+			"country_code":            report.CountryCode,
+			"currency_code":           report.CurrencyCode,
+			"month":                   report.Month,
+			"billable_cost":           report.BillableCost,
+			"non_billable_cost":       report.NonBillableCost,
+			"billable_rated_cost":     report.BillableRatedCost,
+			"non_billable_rated_cost": report.NonBillableRatedCost,
+			"resources":               resources,
+		})
+	}
+	return reports
+}
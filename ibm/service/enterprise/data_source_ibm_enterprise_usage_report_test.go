@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package enterprise_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseUsageReportDataSourceBasic(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmEnterpriseUsageReportDataSourceConfigBasic(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_usage_report.usage_report", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_usage_report.usage_report", "reports.#"),
+					resource.TestCheckResourceAttr("data.ibm_enterprise_usage_report.usage_report", "reports.0.month", month),
+					resource.TestCheckResourceAttr("data.ibm_enterprise_usage_report.usage_report", "reports.0.entity_type", "enterprise"),
+					resource.TestCheckResourceAttrSet("data.ibm_enterprise_usage_report.usage_report", "reports.0.billable_cost"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmEnterpriseUsageReportDataSourceConfigBasic(month string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		data "ibm_enterprise_usage_report" "usage_report" {
+			enterprise_id = data.ibm_enterprises.enterprises_instance.enterprises[0].id
+			month         = "%s"
+		}
+	`, month)
+}
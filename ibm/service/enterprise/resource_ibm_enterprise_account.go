@@ -13,6 +13,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
@@ -34,7 +35,7 @@ func ResourceIBMEnterpriseAccount() *schema.Resource {
 			"parent": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself.",
+				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself. Changing it moves the account to the new parent.",
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -294,6 +295,11 @@ func resourceIbmEnterpriseAccountUpdate(context context.Context, d *schema.Resou
 			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
 			return diag.FromErr(err)
 		}
+		// The move completes asynchronously, the account keeps its old parent
+		// until then
+		if err = waitForEnterpriseAccountMove(context, d, meta, d.Get("parent").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIbmEnterpriseAccountRead(context, d, meta)
@@ -305,3 +311,43 @@ func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.Resou
 
 	return nil
 }
+
+const (
+	enterpriseAccountMoving = "moving"
+	enterpriseAccountMoved  = "moved"
+)
+
+// waitForEnterpriseAccountMove polls the account until its parent is the
+// new parent
+func waitForEnterpriseAccountMove(context context.Context, d *schema.ResourceData, meta interface{}, parent string, timeout time.Duration) error {
+	enterpriseManagementClient, err := meta.(conns.ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return err
+	}
+
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+	getAccountOptions.SetAccountID(d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{enterpriseAccountMoving},
+		Target:  []string{enterpriseAccountMoved},
+		Refresh: func() (interface{}, string, error) {
+			account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting account (%s): %s\n%s", d.Id(), err, response)
+			}
+			if account.Parent != nil && *account.Parent == parent {
+				return account, enterpriseAccountMoved, nil
+			}
+			return account, enterpriseAccountMoving, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	if _, err = stateConf.WaitForStateContext(context); err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for account (%s) to be moved to %s: %s", d.Id(), parent, err)
+	}
+	return nil
+}
@@ -16,7 +16,7 @@ import (
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
 )
 
-/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise with at least two account groups" */
 func TestAccIbmEnterpriseAccountBasic(t *testing.T) {
 	var conf enterprisemanagementv1.Account
 	//parent := fmt.Sprintf("parent_%d", acctest.RandIntRange(10, 100))
@@ -40,11 +40,18 @@ func TestAccIbmEnterpriseAccountBasic(t *testing.T) {
 			{
 				Config: testAccCheckIbmEnterpriseAccountConfigUpdateBasic(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "parent"),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account.enterprise_account", "parent", "data.ibm_enterprise_account_groups.account_groups_instance", "account_groups.0.crn"),
 					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "name"),
 					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "owner_iam_id"),
 				),
 			},
+			{
+				Config: testAccCheckIbmEnterpriseAccountConfigMoveBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account.enterprise_account", "parent", "data.ibm_enterprise_account_groups.account_groups_instance", "account_groups.1.crn"),
+					testAccCheckIbmEnterpriseAccountParent("ibm_enterprise_account.enterprise_account"),
+				),
+			},
 			{
 				ResourceName:      "ibm_enterprise_account.enterprise_account",
 				ImportState:       true,
@@ -99,6 +106,18 @@ func testAccCheckIbmEnterpriseAccountConfigUpdateBasic(name string) string {
 	`, name)
 }
 
+func testAccCheckIbmEnterpriseAccountConfigMoveBasic(name string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprise_account_groups" "account_groups_instance" {
+		}
+		resource "ibm_enterprise_account" "enterprise_account" {
+			parent = data.ibm_enterprise_account_groups.account_groups_instance.account_groups[1].crn
+			name = "%s"
+			owner_iam_id = data.ibm_enterprise_account_groups.account_groups_instance.account_groups[0].primary_contact_iam_id
+		}
+	`, name)
+}
+
 func testAccCheckIbmAccountsDataSourceConfigImportBasic(accountToBeImported string) string {
 
 	return fmt.Sprintf(`
@@ -138,3 +157,38 @@ func testAccCheckIbmEnterpriseAccountExists(n string, obj enterprisemanagementv1
 		return nil
 	}
 }
+
+// testAccCheckIbmEnterpriseAccountParent checks that the move of the account is
+// complete once apply returns, the account reports the parent in state
+func testAccCheckIbmEnterpriseAccountParent(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		enterpriseManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).EnterpriseManagementV1()
+		if err != nil {
+			return err
+		}
+
+		getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+
+		getAccountOptions.SetAccountID(rs.Primary.ID)
+
+		account, _, err := enterpriseManagementClient.GetAccount(getAccountOptions)
+		if err != nil {
+			return err
+		}
+
+		parent := ""
+		if account.Parent != nil {
+			parent = *account.Parent
+		}
+		if parent != rs.Primary.Attributes["parent"] {
+			return fmt.Errorf("Account %s not moved to %s yet, parent is %s", rs.Primary.ID, rs.Primary.Attributes["parent"], parent)
+		}
+		return nil
+	}
+}
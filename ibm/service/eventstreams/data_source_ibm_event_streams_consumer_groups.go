@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEventStreamsConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the consumer group with this ID",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The consumer groups of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the consumer group",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group",
+						},
+						"protocol_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The protocol type of the consumer group",
+						},
+						"member_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of members of the consumer group",
+						},
+						"lag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total lag of the consumer group over all the partitions",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The committed offsets and lag of the consumer group per partition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the topic",
+									},
+									"partition": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The partition ID",
+									},
+									"current_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset committed by the consumer group",
+									},
+									"end_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset of the next message produced to the partition",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of messages the consumer group is behind",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, instanceCRN, err := createSaramaClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead createSaramaClient err %s", err)
+		return diag.FromErr(err)
+	}
+	defer client.Close()
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead NewClusterAdminFromClient err %s", err)
+		return diag.FromErr(err)
+	}
+
+	var groupIDs []string
+	if groupID, ok := d.GetOk("group_id"); ok {
+		groupIDs = []string{groupID.(string)}
+	} else {
+		groups, err := adminClient.ListConsumerGroups()
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead ListConsumerGroups err %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error listing consumer groups: %s", err))
+		}
+		for groupID := range groups {
+			groupIDs = append(groupIDs, groupID)
+		}
+		sort.Strings(groupIDs)
+	}
+
+	consumerGroups := make([]map[string]interface{}, 0, len(groupIDs))
+	if len(groupIDs) > 0 {
+		descriptions, err := adminClient.DescribeConsumerGroups(groupIDs)
+		if err != nil {
+			log.Printf("[DEBUG]dataSourceIBMEventStreamsConsumerGroupsRead DescribeConsumerGroups err %s", err)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error describing consumer groups: %s", err))
+		}
+		for _, description := range descriptions {
+			if description.Err != sarama.ErrNoError {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error describing consumer group %s: %s", description.GroupId, description.Err))
+			}
+			group, err := flattenEventStreamsConsumerGroup(client, adminClient, description)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			consumerGroups = append(consumerGroups, group)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:consumer-groups:%s", instanceCRN, d.Get("group_id").(string)))
+	d.Set("resource_instance_id", instanceCRN)
+	if err = d.Set("consumer_groups", consumerGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting consumer_groups: %s", err))
+	}
+	return nil
+}
+
+func flattenEventStreamsConsumerGroup(client sarama.Client, adminClient sarama.ClusterAdmin, description *sarama.GroupDescription) (map[string]interface{}, error) {
+	offsets, err := adminClient.ListConsumerGroupOffsets(description.GroupId, nil)
+	if err != nil {
+		log.Printf("[DEBUG]flattenEventStreamsConsumerGroup ListConsumerGroupOffsets err %s", err)
+		return nil, fmt.Errorf("[ERROR] Error listing the offsets of consumer group %s: %s", description.GroupId, err)
+	}
+
+	topics := make([]string, 0, len(offsets.Blocks))
+	for topic := range offsets.Blocks {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+
+	var totalLag int64
+	partitions := []map[string]interface{}{}
+	for _, topic := range topics {
+		partitionIDs := make([]int, 0, len(offsets.Blocks[topic]))
+		for partition := range offsets.Blocks[topic] {
+			partitionIDs = append(partitionIDs, int(partition))
+		}
+		sort.Ints(partitionIDs)
+		for _, partition := range partitionIDs {
+			block := offsets.Blocks[topic][int32(partition)]
+			if block.Err != sarama.ErrNoError || block.Offset < 0 {
+				// No offset was committed for the partition
+				continue
+			}
+			endOffset, err := client.GetOffset(topic, int32(partition), sarama.OffsetNewest)
+			if err != nil {
+				log.Printf("[DEBUG]flattenEventStreamsConsumerGroup GetOffset err %s", err)
+				return nil, fmt.Errorf("[ERROR] Error getting the end offset of topic %s partition %d: %s", topic, partition, err)
+			}
+			lag := endOffset - block.Offset
+			if lag < 0 {
+				lag = 0
+			}
+			totalLag += lag
+			partitions = append(partitions, map[string]interface{}{
+				"topic":          topic,
+				"partition":      partition,
+				"current_offset": int(block.Offset),
+				"end_offset":     int(endOffset),
+				"lag":            int(lag),
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"group_id":      description.GroupId,
+		"state":         description.State,
+		"protocol_type": description.ProtocolType,
+		"member_count":  len(description.Members),
+		"lag":           int(totalLag),
+		"partitions":    partitions,
+	}, nil
+}
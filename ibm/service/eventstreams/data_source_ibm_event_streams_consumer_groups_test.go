@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsConsumerGroupsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupsDataSourceConfigBasic(MZREnterpriseInstanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "consumer_groups.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "kafka_brokers_sasl.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConsumerGroupsDataSourceConfigBasic(instanceName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "my_group" {
+		is_default=true
+	  }
+	data "ibm_resource_instance" "es_instance" {
+		resource_group_id = data.ibm_resource_group.my_group.id
+		name              = "%s"
+	}
+	data "ibm_event_streams_consumer_groups" "es_consumer_groups" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+	}`, instanceName)
+}
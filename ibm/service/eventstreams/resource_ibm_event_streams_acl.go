@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMEventStreamsACL() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsACLCreate,
+		ReadContext:   resourceIBMEventStreamsACLRead,
+		DeleteContext: resourceIBMEventStreamsACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMEventStreamsACLImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The CRN of the Event Streams instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API endpoint for interacting with Event Streams REST API",
+			},
+			"kafka_brokers_sasl": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Kafka brokers addresses for interacting with Kafka native API",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the Kafka resource the ACL applies to",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"topic", "group", "cluster", "transactionalid"}, false),
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the Kafka resource, or the prefix of the names when resource_pattern_type is prefixed",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"resource_pattern_type": {
+				Type:         schema.TypeString,
+				Description:  "How resource_name is matched against the names of the resources",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "literal",
+				ValidateFunc: validation.StringInSlice([]string{"literal", "prefixed"}, false),
+			},
+			"principal": {
+				Type:        schema.TypeString,
+				Description: "The principal the ACL applies to, for example User:iam-ServiceId-00000000-0000-0000-0000-000000000000",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Description: "The host the ACL applies to",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "*",
+			},
+			"operation": {
+				Type:        schema.TypeString,
+				Description: "The operation that is allowed or denied",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{"all", "read", "write", "create", "delete", "alter", "describe",
+					"clusteraction", "describeconfigs", "alterconfigs", "idempotentwrite"}, false),
+			},
+			"permission_type": {
+				Type:         schema.TypeString,
+				Description:  "Whether the operation is allowed or denied",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "allow",
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsACLCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLCreate createSaramaAdminClient err %s", err)
+		return diag.FromErr(err)
+	}
+	resource, acl, err := expandEventStreamsACL(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = adminClient.CreateACL(resource, acl)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLCreate CreateACL err %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating ACL for %s %s: %s", d.Get("resource_type").(string), resource.ResourceName, err))
+	}
+	d.SetId(getACLID(instanceCRN, d))
+	log.Printf("[INFO] resourceIBMEventStreamsACLCreate ACL %s is created", d.Id())
+	return resourceIBMEventStreamsACLRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsACLRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, instanceCRN, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLRead createSaramaAdminClient err %s", err)
+		return diag.FromErr(err)
+	}
+	filter, err := eventStreamsACLFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resourceAcls, err := adminClient.ListAcls(filter)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLRead ListAcls err %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing ACLs: %s", err))
+	}
+	found := false
+	for _, resourceAcl := range resourceAcls {
+		if len(resourceAcl.Acls) > 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		log.Printf("[INFO] resourceIBMEventStreamsACLRead ACL %s does not exist", d.Id())
+		d.SetId("")
+		return nil
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	return nil
+}
+
+func resourceIBMEventStreamsACLDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminClient, _, err := createSaramaAdminClient(d, meta)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete createSaramaAdminClient err %s", err)
+		return diag.FromErr(err)
+	}
+	filter, err := eventStreamsACLFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = adminClient.DeleteACL(filter, false)
+	if err != nil {
+		log.Printf("[DEBUG] resourceIBMEventStreamsACLDelete DeleteACL err %s", err)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting ACL %s: %s", d.Id(), err))
+	}
+	d.SetId("")
+	log.Printf("[INFO] resourceIBMEventStreamsACLDelete ACL is deleted")
+	return nil
+}
+
+func resourceIBMEventStreamsACLImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "|")
+	if len(parts) != 8 {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceCRN|resourceType|resourceName|resourcePatternType|principal|host|operation|permissionType", d.Id())
+	}
+	d.Set("resource_instance_id", parts[0])
+	d.Set("resource_type", parts[1])
+	d.Set("resource_name", parts[2])
+	d.Set("resource_pattern_type", parts[3])
+	d.Set("principal", parts[4])
+	d.Set("host", parts[5])
+	d.Set("operation", parts[6])
+	d.Set("permission_type", parts[7])
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandEventStreamsACL(d *schema.ResourceData) (sarama.Resource, sarama.Acl, error) {
+	resource := sarama.Resource{
+		ResourceName: d.Get("resource_name").(string),
+	}
+	acl := sarama.Acl{
+		Principal: d.Get("principal").(string),
+		Host:      d.Get("host").(string),
+	}
+	if err := resource.ResourceType.UnmarshalText([]byte(d.Get("resource_type").(string))); err != nil {
+		return resource, acl, err
+	}
+	if err := resource.ResourcePatternType.UnmarshalText([]byte(d.Get("resource_pattern_type").(string))); err != nil {
+		return resource, acl, err
+	}
+	if err := acl.Operation.UnmarshalText([]byte(d.Get("operation").(string))); err != nil {
+		return resource, acl, err
+	}
+	if err := acl.PermissionType.UnmarshalText([]byte(d.Get("permission_type").(string))); err != nil {
+		return resource, acl, err
+	}
+	return resource, acl, nil
+}
+
+// eventStreamsACLFilter returns a filter that only matches the ACL of the resource
+func eventStreamsACLFilter(d *schema.ResourceData) (sarama.AclFilter, error) {
+	resource, acl, err := expandEventStreamsACL(d)
+	if err != nil {
+		return sarama.AclFilter{}, err
+	}
+	return sarama.AclFilter{
+		Version:                   1,
+		ResourceType:              resource.ResourceType,
+		ResourceName:              &resource.ResourceName,
+		ResourcePatternTypeFilter: resource.ResourcePatternType,
+		Principal:                 &acl.Principal,
+		Host:                      &acl.Host,
+		Operation:                 acl.Operation,
+		PermissionType:            acl.PermissionType,
+	}, nil
+}
+
+func getACLID(instanceCRN string, d *schema.ResourceData) string {
+	return strings.Join([]string{
+		instanceCRN,
+		d.Get("resource_type").(string),
+		d.Get("resource_name").(string),
+		d.Get("resource_pattern_type").(string),
+		d.Get("principal").(string),
+		d.Get("host").(string),
+		d.Get("operation").(string),
+		d.Get("permission_type").(string),
+	}, "|")
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsACLResourceBasic(t *testing.T) {
+	topicName := fmt.Sprintf("es_topic_%d", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsACLWithExistingInstance(MZREnterpriseInstanceName, topicName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_acl.es_acl", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_type", "topic"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_name", topicName),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "resource_pattern_type", "prefixed"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "host", "*"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "operation", "read"),
+					resource.TestCheckResourceAttr("ibm_event_streams_acl.es_acl", "permission_type", "allow"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_acl.es_acl",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsACLWithExistingInstance(instanceName string, topicName string) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_acl" "es_acl" {
+		resource_instance_id  = data.ibm_resource_instance.es_instance.id
+		resource_type         = "topic"
+		resource_name         = "%s"
+		resource_pattern_type = "prefixed"
+		principal             = "User:iam-ServiceId-00000000-0000-0000-0000-000000000000"
+		operation             = "read"
+	}`, topicName)
+}
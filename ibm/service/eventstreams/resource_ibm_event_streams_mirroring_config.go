@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMEventStreamsMirroringConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsMirroringConfigCreate,
+		ReadContext:   resourceIBMEventStreamsMirroringConfigRead,
+		UpdateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		DeleteContext: resourceIBMEventStreamsMirroringConfigDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance that mirrors the topics",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"mirroring_topic_patterns": {
+				Type:        schema.TypeList,
+				Description: "The topic name patterns (regular expressions) of the source instance to mirror",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"active_topics": {
+				Type:        schema.TypeList,
+				Description: "The topics that are being mirrored",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsMirroringConfigCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := getAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = replaceMirroringTopicSelection(context, adminrestClient, flex.ExpandStringList(d.Get("mirroring_topic_patterns").([]interface{})))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(getMirroringConfigID(instanceCRN))
+	return resourceIBMEventStreamsMirroringConfigRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsMirroringConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := getAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	selection, response, err := adminrestClient.GetMirroringTopicSelectionWithContext(context, &adminrestv1.GetMirroringTopicSelectionOptions{})
+	if err != nil || selection == nil {
+		log.Printf("[DEBUG] GetMirroringTopicSelectionWithContext failed with error: %s and response: \n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetMirroringTopicSelectionWithContext failed %s\n%s", err, response))
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("mirroring_topic_patterns", selection.Includes)
+
+	activeTopics, response, err := adminrestClient.GetMirroringActiveTopicsWithContext(context, &adminrestv1.GetMirroringActiveTopicsOptions{})
+	if err != nil || activeTopics == nil {
+		log.Printf("[DEBUG] GetMirroringActiveTopicsWithContext failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetMirroringActiveTopicsWithContext failed %s\n%s", err, response))
+	}
+	d.Set("active_topics", activeTopics.ActiveTopics)
+	return nil
+}
+
+func resourceIBMEventStreamsMirroringConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("mirroring_topic_patterns") {
+		adminrestClient, _, err := getAdminRestClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = replaceMirroringTopicSelection(context, adminrestClient, flex.ExpandStringList(d.Get("mirroring_topic_patterns").([]interface{})))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIBMEventStreamsMirroringConfigRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsMirroringConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, _, err := getAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Mirroring itself is enabled when the instance is provisioned, removing the
+	// configuration stops mirroring all the topics.
+	err = replaceMirroringTopicSelection(context, adminrestClient, []string{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("")
+	return nil
+}
+
+func replaceMirroringTopicSelection(context context.Context, adminrestClient *adminrestv1.AdminrestV1, includes []string) error {
+	replaceMirroringTopicSelectionOptions := &adminrestv1.ReplaceMirroringTopicSelectionOptions{
+		Includes: includes,
+	}
+	_, response, err := adminrestClient.ReplaceMirroringTopicSelectionWithContext(context, replaceMirroringTopicSelectionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ReplaceMirroringTopicSelectionWithContext failed with error: %s and response: \n%s", err, response)
+		return fmt.Errorf("ReplaceMirroringTopicSelectionWithContext failed with error: %s and response: \n%s", err, response)
+	}
+	return nil
+}
+
+func getMirroringConfigID(instanceCRN string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "mirroring-config"
+	crnSegments[9] = ""
+	return strings.Join(crnSegments, ":")
+}
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsQuotaCreate,
+		ReadContext:   resourceIBMEventStreamsQuotaRead,
+		UpdateContext: resourceIBMEventStreamsQuotaUpdate,
+		DeleteContext: resourceIBMEventStreamsQuotaDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"entity": {
+				Type:        schema.TypeString,
+				Description: "The entity the quota applies to, 'default' or the IAM ID of a user or service ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"producer_byte_rate": {
+				Type:         schema.TypeInt,
+				Description:  "The producer throughput quota in bytes per second, -1 means no quota",
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"consumer_byte_rate": {
+				Type:         schema.TypeInt,
+				Description:  "The consumer throughput quota in bytes per second, -1 means no quota",
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+		},
+	}
+}
+
+// eventStreamsQuota is the quota of an entity in the admin REST API
+type eventStreamsQuota struct {
+	ProducerByteRate *int64 `json:"producer_byte_rate,omitempty"`
+	ConsumerByteRate *int64 `json:"consumer_byte_rate,omitempty"`
+}
+
+func resourceIBMEventStreamsQuotaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := getAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := d.Get("entity").(string)
+	response, err := eventStreamsQuotaRequest(context, adminrestClient, core.POST, entity, expandEventStreamsQuota(d), nil)
+	if err != nil {
+		log.Printf("[DEBUG] CreateQuota failed with error: %s and response: \n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateQuota failed with error: %s and response: \n%s", err, response))
+	}
+	d.SetId(getQuotaID(instanceCRN, entity))
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := getAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := getQuotaEntity(d.Id())
+	quota := &eventStreamsQuota{}
+	response, err := eventStreamsQuotaRequest(context, adminrestClient, core.GET, entity, nil, quota)
+	if err != nil {
+		log.Printf("[DEBUG] GetQuota failed with error: %s and response: \n%s", err, response)
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("GetQuota failed %s\n%s", err, response))
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("entity", entity)
+	d.Set("producer_byte_rate", -1)
+	if quota.ProducerByteRate != nil {
+		d.Set("producer_byte_rate", *quota.ProducerByteRate)
+	}
+	d.Set("consumer_byte_rate", -1)
+	if quota.ConsumerByteRate != nil {
+		d.Set("consumer_byte_rate", *quota.ConsumerByteRate)
+	}
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("producer_byte_rate", "consumer_byte_rate") {
+		adminrestClient, _, err := getAdminRestClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		entity := getQuotaEntity(d.Id())
+		response, err := eventStreamsQuotaRequest(context, adminrestClient, core.PATCH, entity, expandEventStreamsQuota(d), nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateQuota failed with error: %s and response: \n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateQuota failed with error: %s and response: \n%s", err, response))
+		}
+	}
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, _, err := getAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := getQuotaEntity(d.Id())
+	response, err := eventStreamsQuotaRequest(context, adminrestClient, core.DELETE, entity, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteQuota failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteQuota failed %s\n%s", err, response))
+	}
+	d.SetId("")
+	return nil
+}
+
+func expandEventStreamsQuota(d *schema.ResourceData) *eventStreamsQuota {
+	producerByteRate := int64(d.Get("producer_byte_rate").(int))
+	consumerByteRate := int64(d.Get("consumer_byte_rate").(int))
+	return &eventStreamsQuota{
+		ProducerByteRate: &producerByteRate,
+		ConsumerByteRate: &consumerByteRate,
+	}
+}
+
+// eventStreamsQuotaRequest calls the quotas endpoint of the admin REST API,
+// which is not covered by the adminrestv1 SDK version in use.
+func eventStreamsQuotaRequest(context context.Context, adminrestClient *adminrestv1.AdminrestV1, method string, entity string, body *eventStreamsQuota, result *eventStreamsQuota) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = adminrestClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(adminrestClient.Service.Options.URL, `/admin/quotas/{entity_name}`, map[string]string{
+		"entity_name": entity,
+	})
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if result != nil {
+		return adminrestClient.Service.Request(request, result)
+	}
+	return adminrestClient.Service.Request(request, nil)
+}
+
+// getAdminRestClient returns an admin REST client for the instance of the resource
+func getAdminRestClient(d *schema.ResourceData, meta interface{}) (*adminrestv1.AdminrestV1, string, error) {
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return nil, "", err
+	}
+	instanceCRN := d.Get("resource_instance_id").(string)
+	if len(instanceCRN) == 0 {
+		id := d.Id()
+		if len(id) == 0 || !strings.Contains(id, ":") {
+			log.Printf("[DEBUG] getAdminRestClient resource_instance_id is missing")
+			return nil, "", fmt.Errorf("resource_instance_id is required")
+		}
+		instanceCRN = getInstanceCRN(id)
+	}
+	instance, err := getInstanceDetails(instanceCRN, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	adminURL := instance.Extensions["kafka_http_url"].(string)
+	d.Set("kafka_http_url", adminURL)
+	log.Printf("[INFO] getAdminRestClient kafka_http_url is set to %s", adminURL)
+	if err = adminrestClient.SetServiceURL(adminURL); err != nil {
+		return nil, "", err
+	}
+	return adminrestClient, instanceCRN, nil
+}
+
+func getQuotaID(instanceCRN string, entity string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "quota"
+	crnSegments[9] = entity
+	return strings.Join(crnSegments, ":")
+}
+
+func getQuotaEntity(id string) string {
+	return strings.Split(id, ":")[9]
+}
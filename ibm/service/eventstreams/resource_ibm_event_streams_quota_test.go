@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMEventStreamsQuotaResourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(MZREnterpriseInstanceName, 1024, -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_quota.es_quota", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", "default"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "1024"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "-1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(MZREnterpriseInstanceName, 2048, 4096),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "2048"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "4096"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_quota.es_quota",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsQuotaWithExistingInstance(instanceName string, producerByteRate int, consumerByteRate int) string {
+	return getPlatformResource(instanceName) + "\n" + fmt.Sprintf(`
+	resource "ibm_event_streams_quota" "es_quota" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		entity               = "default"
+		producer_byte_rate   = %d
+		consumer_byte_rate   = %d
+	}`, producerByteRate, consumerByteRate)
+}
+
+func TestGetQuotaID(t *testing.T) {
+	quotaID := "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:quota:default"
+	assert.Equal(t, quotaID, getQuotaID(instanceCRN, "default"))
+	assert.Equal(t, "default", getQuotaEntity(quotaID))
+	assert.Equal(t, instanceCRN, getInstanceCRN(quotaID))
+}
+
+func getQuotaID(instanceCRN string, entity string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "quota"
+	crnSegments[9] = entity
+	return strings.Join(crnSegments, ":")
+}
+
+func getQuotaEntity(id string) string {
+	return strings.Split(id, ":")[9]
+}
@@ -251,6 +251,24 @@ func resourceIBMEventStreamsTopicDelete(d *schema.ResourceData, meta interface{}
 }
 
 func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.ClusterAdmin, string, error) {
+	client, instanceCRN, err := createSaramaClient(d, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	adminClient, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
+		client.Close()
+		return nil, "", err
+	}
+	clientPool[instanceCRN] = adminClient
+	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
+	return adminClient, instanceCRN, nil
+}
+
+// createSaramaClient dials the Kafka brokers of the instance, the client can be
+// used for the Kafka APIs that are not available to the cluster admin.
+func createSaramaClient(d *schema.ResourceData, meta interface{}) (sarama.Client, string, error) {
 	bxSession, err := meta.(conns.ClientSession).BluemixSession()
 	if err != nil {
 		log.Printf("[DEBUG] createSaramaAdminClient BluemixSession err %s", err)
@@ -297,14 +315,12 @@ func createSaramaAdminClient(d *schema.ResourceData, meta interface{}) (sarama.C
 	config.Net.TLS.Enable = true
 	config.Version = brokerVersion
 	config.Admin.Timeout = adminClientTimeout
-	adminClient, err := sarama.NewClusterAdmin(brokerAddress, config)
+	client, err := sarama.NewClient(brokerAddress, config)
 	if err != nil {
-		log.Printf("[DEBUG] createSaramaAdminClient NewClusterAdmin err %s", err)
+		log.Printf("[DEBUG] createSaramaClient NewClient err %s", err)
 		return nil, "", err
 	}
-	clientPool[instanceCRN] = adminClient
-	log.Printf("[INFO] createSaramaAdminClient instance %s 's client is initialized", instanceCRN)
-	return adminClient, instanceCRN, nil
+	return client, instanceCRN, nil
 }
 
 func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*string {
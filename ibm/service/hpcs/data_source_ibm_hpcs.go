@@ -93,6 +93,21 @@ func DataSourceIBMHPCS() *schema.Resource {
 				Computed:    true,
 				Description: "The extended metadata as a map associated with the resource instance.",
 			},
+			"initialization_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The initialization status of the crypto units, not_initialized, in_progress or initialized",
+			},
+			"initialized_units": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of crypto units that have a valid current master key",
+			},
+			"master_key_verification_pattern": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The verification pattern of the current master key shared by the initialized crypto units",
+			},
 			"hsm_info": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -287,6 +302,7 @@ func dataSourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Quering HSM config %s", err))
 	}
 	d.Set("hsm_info", FlattenHSMInfo(hsmInfo))
+	setHPCSInitializationStatus(d, hsmInfo)
 
 	return nil
 }
@@ -316,3 +332,38 @@ func FlattenHSMInfo(hsmInfo []tkesdk.HsmInfo) []map[string]interface{} {
 	}
 	return info
 }
+
+// hpcsInitializationStatus summarizes the master key state of the crypto units.
+// The units are initialized when they all have the same valid current master key.
+func hpcsInitializationStatus(hsmInfo []tkesdk.HsmInfo) (status string, initialized int, mkvp string) {
+	started := false
+	for _, h := range hsmInfo {
+		if h.CurrentMKStatus == "Valid" {
+			initialized++
+			if mkvp == "" {
+				mkvp = h.CurrentMKVP
+			} else if mkvp != h.CurrentMKVP {
+				mkvp = ""
+				started = true
+			}
+		}
+		if len(h.Admins) > 0 || (h.NewMKStatus != "" && h.NewMKStatus != "Empty") {
+			started = true
+		}
+	}
+	switch {
+	case len(hsmInfo) > 0 && initialized == len(hsmInfo) && mkvp != "":
+		return "initialized", initialized, mkvp
+	case initialized > 0 || started:
+		return "in_progress", initialized, mkvp
+	default:
+		return "not_initialized", initialized, mkvp
+	}
+}
+
+func setHPCSInitializationStatus(d *schema.ResourceData, hsmInfo []tkesdk.HsmInfo) {
+	status, initialized, mkvp := hpcsInitializationStatus(hsmInfo)
+	d.Set("initialization_status", status)
+	d.Set("initialized_units", initialized)
+	d.Set("master_key_verification_pattern", mkvp)
+}
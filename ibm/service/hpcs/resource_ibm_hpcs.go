@@ -44,7 +44,7 @@ func ResourceIBMHPCS() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
-				return flex.ImmutableResourceCustomizeDiff([]string{"units", "failover_units", "location", "resource_group_id", "service"}, diff)
+				return flex.ImmutableResourceCustomizeDiff([]string{"units", "location", "resource_group_id", "service"}, diff)
 			},
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
@@ -239,6 +239,21 @@ func ResourceIBMHPCS() *schema.Resource {
 					},
 				},
 			},
+			"initialization_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The initialization status of the crypto units, not_initialized, in_progress or initialized",
+			},
+			"initialized_units": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of crypto units that have a valid current master key",
+			},
+			"master_key_verification_pattern": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The verification pattern of the current master key shared by the initialized crypto units",
+			},
 			"hsm_info": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -568,6 +583,7 @@ func resourceIBMHPCSRead(context context.Context, d *schema.ResourceData, meta i
 		return diag.FromErr(fmt.Errorf("[ERROR] Error Quering HSM config: %s", err))
 	}
 	d.Set("hsm_info", FlattenHSMInfo(hsmInfo))
+	setHPCSInitializationStatus(d, hsmInfo)
 
 	if validateHSM(hsmInfo) && !d.IsNewResource() {
 		d.Set("admins", nil)
@@ -617,12 +633,21 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		update = true
 
 	}
-	if d.HasChange("service_endpoints") {
+	if d.HasChange("service_endpoints") || d.HasChange("failover_units") {
 		params := HPCSParams{}
-		params.ServiceEndpoints = d.Get("service_endpoints").(string)
+		if d.HasChange("service_endpoints") {
+			params.ServiceEndpoints = d.Get("service_endpoints").(string)
+		}
+		if d.HasChange("failover_units") {
+			params.FailoverUnits = d.Get("failover_units").(int)
+		}
 		parameters, _ := json.Marshal(params)
-		var raw map[string]interface{}
+		raw := map[string]interface{}{}
 		json.Unmarshal(parameters, &raw)
+		if d.HasChange("failover_units") {
+			// Zero failover units are dropped by omitempty, they must be sent to remove the units
+			raw["failover_units"] = params.FailoverUnits
+		}
 		resourceInstanceUpdate.Parameters = raw
 		update = true
 	}
@@ -654,7 +679,7 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		}
 	}
 	// Initialise HPCS Crypto Units
-
+	var diags diag.Diagnostics
 	if d.HasChange("signature_threshold") || d.HasChange("revocation_threshold") || d.HasChange("admins") || d.HasChange("signature_server_url") {
 		if url, ok := d.GetOk("signature_server_url"); ok {
 			serverURL := url.(string)
@@ -687,8 +712,9 @@ func resourceIBMHPCSUpdate(context context.Context, d *schema.ResourceData, meta
 		if len(hsmDetails) != 0 {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error Updating Crypto Units..One or more problems were found during initial checks: %v", hsmDetails))
 		}
+		diags = hpcsInitializationProgress(ci)
 	}
-	return resourceIBMHPCSRead(context, d, meta)
+	return append(diags, resourceIBMHPCSRead(context, d, meta)...)
 }
 func expandHSMConfig(d *schema.ResourceData, meta interface{}) tkesdk.HsmConfig {
 	hsmConfig := tkesdk.HsmConfig{}
@@ -867,6 +893,34 @@ func waitForHPCSInstanceDelete(d *schema.ResourceData, meta interface{}) (interf
 
 	return stateConf.WaitForState()
 }
+
+// hpcsInitializationProgress logs the master key state of each crypto unit
+// after an update, and warns when the initialization is not complete
+func hpcsInitializationProgress(ci tkesdk.CommonInputs) diag.Diagnostics {
+	hsmInfo, err := tkesdk.Query(ci)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The initialization progress of the HPCS crypto units is unknown",
+			Detail:   fmt.Sprintf("Error Quering HSM config after update: %s", err),
+		}}
+	}
+	for _, h := range hsmInfo {
+		log.Printf("[INFO] HPCS crypto unit %s (%s, %s): current master key %s (verification pattern %s), new master key %s (verification pattern %s)",
+			h.HsmId, h.HsmType, h.HsmLocation, h.CurrentMKStatus, h.CurrentMKVP, h.NewMKStatus, h.NewMKVP)
+	}
+	status, initialized, mkvp := hpcsInitializationStatus(hsmInfo)
+	log.Printf("[INFO] HPCS initialization %s: %d of %d crypto units initialized, master key verification pattern %q", status, initialized, len(hsmInfo), mkvp)
+	if status == "initialized" {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The HPCS initialization is %s", status),
+		Detail:   fmt.Sprintf("%d of %d crypto units are initialized with a valid current master key.", initialized, len(hsmInfo)),
+	}}
+}
+
 func resourceIBMHPCSAdminHash(v interface{}) int {
 	var buf bytes.Buffer
 	a := v.(map[string]interface{})
@@ -59,6 +59,16 @@ func TestAccIBMHPCSInstanceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "admins.#", "1"),
 				),
 			},
+			{
+				Config: testAccCheckIBMHPCSInstanceFailoverUnitsUpdate(testName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMHPCSInstanceExists(name, hpcsInstance),
+					resource.TestCheckResourceAttr(name, "units", "2"),
+					resource.TestCheckResourceAttr(name, "failover_units", "2"),
+					resource.TestCheckResourceAttrSet(name, "initialization_status"),
+					resource.TestCheckResourceAttrSet(name, "initialized_units"),
+				),
+			},
 			{
 				Config:      testAccCheckIBMHPCSInstanceUnitsUpdate(testName),
 				ExpectError: regexp.MustCompile(`'units' attribute is immutable and can't be changed`),
@@ -186,6 +196,24 @@ func testAccCheckIBMHPCSInstanceAdminDelete(name string) string {
 	}
 	`, name, acc.HpcsAdmin1, acc.HpcsToken1)
 }
+func testAccCheckIBMHPCSInstanceFailoverUnitsUpdate(name string) string {
+	return fmt.Sprintf(`
+	resource ibm_hpcs hpcs {
+		location             = "us-south"
+		name                 = "%s"
+		plan                 = "standard"
+		units                = 2
+		failover_units       = 2
+		signature_threshold  = 1
+		revocation_threshold = 1
+		admins {
+			name  = "ad1"
+			key   = "%s"
+			token = "%s"
+		}
+	}
+	`, name, acc.HpcsAdmin1, acc.HpcsToken1)
+}
 func testAccCheckIBMHPCSInstanceUnitsUpdate(name string) string {
 	return fmt.Sprintf(`
 	resource ibm_hpcs hpcs {
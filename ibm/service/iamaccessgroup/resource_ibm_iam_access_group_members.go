@@ -9,6 +9,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
@@ -53,6 +54,13 @@ func ResourceIBMIAMAccessGroupMembers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, members of the access group that are not listed are removed, including the members added outside of Terraform",
+			},
+
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -94,7 +102,8 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 	services := flex.ExpandStringList(d.Get("iam_service_ids").(*schema.Set).List())
 	profiles := flex.ExpandStringList(d.Get("iam_profile_ids").(*schema.Set).List())
 
-	if len(users) == 0 && len(services) == 0 && len(profiles) == 0 {
+	// An exclusive membership can be empty, all the members of the group are removed
+	if len(users) == 0 && len(services) == 0 && len(profiles) == 0 && !d.Get("exclusive").(bool) {
 		return diag.FromErr(fmt.Errorf("ERROR] Provide either `ibm_ids` or `iam_service_ids` or `iam_profile_ids`"))
 
 	}
@@ -114,13 +123,20 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
+	if d.Get("exclusive").(bool) {
+		err = reconcileAccessGroupMembers(iamAccessGroupsClient, grpID, userids, serviceids, profileids)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		members := prepareMemberAddRequest(iamAccessGroupsClient, userids, serviceids, profileids)
 
-	addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
-	addMembersToAccessGroupOptions.SetMembers(members)
-	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
-	if err != nil || membership == nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse))
+		addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+		addMembersToAccessGroupOptions.SetMembers(members)
+		membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+		if err != nil || membership == nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse))
+		}
 	}
 
 	d.SetId(fmt.Sprintf("%s/%s", grpID, time.Now().UTC().String()))
@@ -140,25 +156,9 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	}
 
 	grpID := parts[0]
-	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
-	offset := int64(0)
-	// lets fetch 100 in a single pagination
-	limit := int64(100)
-	listAccessGroupMembersOptions.SetLimit(limit)
-	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	allMembers, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
-	}
-	allMembers := members.Members
-	totalMembers := flex.IntValue(members.TotalCount)
-	for len(allMembers) < totalMembers {
-		offset = offset + limit
-		listAccessGroupMembersOptions.SetOffset(offset)
-		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
-		}
-		allMembers = append(allMembers, members.Members...)
+		return diag.FromErr(err)
 	}
 
 	d.Set("access_group_id", grpID)
@@ -233,6 +233,25 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 
 	d.Set("members", flex.FlattenAccessGroupMembers(allMembers, res, allrecs))
 	ibmID, serviceID, profileID := flex.FlattenMembersData(allMembers, res, allrecs, allprofiles)
+	if d.Get("exclusive").(bool) {
+		// All the members are set, so that the members added outside of Terraform
+		// show up as drift. Members that aren't users, service IDs or trusted
+		// profiles of the account are reported with their IAM ID.
+		for _, m := range unresolvedAccessGroupMembers(allMembers, ibmID, serviceID, profileID, res, allrecs, allprofiles) {
+			switch *m.Type {
+			case "user":
+				ibmID = append(ibmID, *m.IamID)
+			case "profile":
+				profileID = append(profileID, *m.IamID)
+			default:
+				serviceID = append(serviceID, *m.IamID)
+			}
+		}
+		d.Set("ibm_ids", ibmID)
+		d.Set("iam_service_ids", serviceID)
+		d.Set("iam_profile_ids", profileID)
+		return nil
+	}
 	if len(ibmID) > 0 {
 		d.Set("ibm_ids", ibmID)
 	}
@@ -265,6 +284,29 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 
 	accountID := userDetails.UserAccount
 
+	if d.Get("exclusive").(bool) {
+		users := flex.ExpandStringList(d.Get("ibm_ids").(*schema.Set).List())
+		services := flex.ExpandStringList(d.Get("iam_service_ids").(*schema.Set).List())
+		profiles := flex.ExpandStringList(d.Get("iam_profile_ids").(*schema.Set).List())
+		userids, err := flex.FlattenUserIds(accountID, users, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		serviceids, err := FlattenServiceIds(services, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		profileids, err := FlattenProfileIds(profiles, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = reconcileAccessGroupMembers(iamAccessGroupsClient, grpID, userids, serviceids, profileids)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
+	}
+
 	var removeUsers, addUsers, removeServiceids, addServiceids, removeProfileids, addProfileids []string
 	o, n := d.GetChange("ibm_ids")
 	ou := o.(*schema.Set)
@@ -445,6 +487,121 @@ func resourceIBMIAMAccessGroupMembersDelete(context context.Context, d *schema.R
 	return nil
 }
 
+// listAccessGroupMembers lists all the members of the access group grpID
+func listAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string) ([]iamaccessgroupsv2.ListGroupMembersResponseMember, error) {
+	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
+	offset := int64(0)
+	// lets fetch 100 in a single pagination
+	limit := int64(100)
+	listAccessGroupMembersOptions.SetLimit(limit)
+	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+	}
+	allMembers := members.Members
+	totalMembers := flex.IntValue(members.TotalCount)
+	for len(allMembers) < totalMembers {
+		offset = offset + limit
+		listAccessGroupMembersOptions.SetOffset(offset)
+		members, detailedResponse, err = iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+		}
+		allMembers = append(allMembers, members.Members...)
+	}
+	return allMembers, nil
+}
+
+// reconcileAccessGroupMembers makes the IAM IDs of users, services and profiles
+// the only members of the access group grpID. The members that aren't listed are removed.
+func reconcileAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string, userIds, serviceIds, profileIds []string) error {
+	current, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
+	if err != nil {
+		return err
+	}
+	desired := map[string]bool{}
+	for _, ids := range [][]string{userIds, serviceIds, profileIds} {
+		for _, id := range ids {
+			desired[id] = true
+		}
+	}
+	existing := map[string]bool{}
+	for _, m := range current {
+		existing[*m.IamID] = true
+		if desired[*m.IamID] {
+			continue
+		}
+		log.Printf("[INFO] Removing member (%s) that is not managed by Terraform from access group (%s)", *m.IamID, grpID)
+		removeMemberFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, *m.IamID)
+		detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMemberFromAccessGroupOptions)
+		if err != nil && (detailResponse == nil || detailResponse.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error removing member (%s) from group(%s). API Response: %s", *m.IamID, grpID, detailResponse)
+		}
+	}
+
+	var addUsers, addServices, addProfiles []string
+	for _, id := range userIds {
+		if !existing[id] {
+			addUsers = append(addUsers, id)
+		}
+	}
+	for _, id := range serviceIds {
+		if !existing[id] {
+			addServices = append(addServices, id)
+		}
+	}
+	for _, id := range profileIds {
+		if !existing[id] {
+			addProfiles = append(addProfiles, id)
+		}
+	}
+	if len(addUsers) == 0 && len(addServices) == 0 && len(addProfiles) == 0 {
+		return nil
+	}
+	members := prepareMemberAddRequest(iamAccessGroupsClient, addUsers, addServices, addProfiles)
+	addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grpID)
+	addMembersToAccessGroupOptions.SetMembers(members)
+	membership, detailResponse, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+	if err != nil || membership == nil {
+		return fmt.Errorf("[ERROR] Error adding members to group(%s). API response: %s", grpID, detailResponse)
+	}
+	return nil
+}
+
+// unresolvedAccessGroupMembers returns the members that FlattenMembersData
+// couldn't map to a user, service ID or trusted profile of the account.
+func unresolvedAccessGroupMembers(list []iamaccessgroupsv2.ListGroupMembersResponseMember, ibmIDs, serviceIDs, profileIDs []string, users []usermanagementv2.UserInfo, services []iamidentityv1.ServiceID, profiles []iamidentityv1.TrustedProfile) []iamaccessgroupsv2.ListGroupMembersResponseMember {
+	resolved := map[string]bool{}
+	for _, user := range users {
+		for _, email := range ibmIDs {
+			if user.Email == email {
+				resolved[user.IamID] = true
+			}
+		}
+	}
+	for _, service := range services {
+		for _, id := range serviceIDs {
+			if *service.ID == id {
+				resolved[*service.IamID] = true
+			}
+		}
+	}
+	for _, profile := range profiles {
+		for _, id := range profileIDs {
+			if *profile.ID == id {
+				resolved[*profile.IamID] = true
+			}
+		}
+	}
+	unresolved := []iamaccessgroupsv2.ListGroupMembersResponseMember{}
+	for _, m := range list {
+		if !resolved[*m.IamID] {
+			unresolved = append(unresolved, m)
+		}
+	}
+	return unresolved
+}
+
 func prepareMemberAddRequest(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, userIds, serviceIds, profileIds []string) (members []iamaccessgroupsv2.AddGroupMembersRequestMembersItem) {
 	members = make([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem, len(userIds)+len(serviceIds)+len(profileIds))
 	var i = 0
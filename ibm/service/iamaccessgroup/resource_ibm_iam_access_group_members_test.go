@@ -107,6 +107,65 @@ func TestAccIBMIAMAccessGroupMember_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupMember_Exclusive(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname1 := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "exclusive", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+					// Adds a member outside of Terraform, the next plan removes it
+					testAccAddIBMIAMAccessGroupMemberOutOfBand("ibm_iam_access_group.accgroup", "ibm_iam_service_id.outside"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAddIBMIAMAccessGroupMemberOutOfBand(group, serviceID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		iamAccessGroupsClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
+		if err != nil {
+			return err
+		}
+		grp, ok := s.RootModule().Resources[group]
+		if !ok {
+			return fmt.Errorf("Not found: %s", group)
+		}
+		service, ok := s.RootModule().Resources[serviceID]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceID)
+		}
+		member, err := iamAccessGroupsClient.NewAddGroupMembersRequestMembersItem(service.Primary.Attributes["iam_id"], "service")
+		if err != nil {
+			return err
+		}
+		addMembersToAccessGroupOptions := iamAccessGroupsClient.NewAddMembersToAccessGroupOptions(grp.Primary.ID)
+		addMembersToAccessGroupOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{*member})
+		_, response, err := iamAccessGroupsClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error adding member to access group: %s\n%s", err, response)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMIAMAccessGroupMemberDestroy(s *terraform.State) error {
 	accClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMAccessGroupsV2()
 	if err != nil {
@@ -301,3 +360,25 @@ func testAccCheckIBMIAMAccessGroupMemberImport(name, sname, pname string) string
 		iam_profile_ids = [ibm_iam_trusted_profile.profileID.id]
 	}`, name, sname, pname, acc.IAMUser)
 }
+
+func testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1 string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+  		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+  		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "outside" {
+  		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgroupmem" {
+  		access_group_id = ibm_iam_access_group.accgroup.id
+  		iam_service_ids = [ibm_iam_service_id.serviceID.id]
+  		exclusive       = true
+	}`, name, sname, sname1)
+}
@@ -0,0 +1,589 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// apiKeyGetter is implemented by schema.ResourceData and schema.ResourceDiff
+type apiKeyGetter interface {
+	Get(key string) interface{}
+}
+
+// apiKeyLifecycleSchema returns the expiry, rotation and Secrets Manager attributes
+// of ibm_iam_api_key and ibm_iam_service_api_key
+func apiKeyLifecycleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"expires_at": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			Description:  "The date and time in RFC3339 format after which the API key is replaced by the next apply",
+		},
+		"expired": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether expires_at of the API key has passed, an expired API key is replaced by the next apply",
+		},
+		"rotation": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Rotates the API key, a new key is created and the previous key is deleted after the overlap window",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rotation_days": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The number of days after which the API key is rotated",
+					},
+					"overlap_hours": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      24,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The number of hours the previous API key stays valid after a rotation",
+					},
+				},
+			},
+		},
+		"next_rotation_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time of the next rotation of the API key",
+		},
+		"previous_apikey_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the API key replaced by the last rotation, while it is in its overlap window",
+		},
+		"previous_apikey_delete_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date and time after which the previous API key is deleted",
+		},
+		"store_in_secrets_manager": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Stores the API key value as arbitrary secret in a Secrets Manager instance, the secret is rotated when the API key is rotated or replaced",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"instance_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ID of the Secrets Manager instance",
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "The region of the Secrets Manager instance, defaults to the region of the provider",
+					},
+					"endpoint_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "public",
+						ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+						Description:  "The endpoint type of the Secrets Manager instance, public or private",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The name of the secret",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The description of the secret",
+					},
+					"secret_group_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The ID of the secret group of the secret, defaults to the default secret group",
+					},
+					"labels": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Set:         schema.HashString,
+						Description: "The labels of the secret",
+					},
+					"secret_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the arbitrary secret, usable with the ibm_secrets_manager_secret data source",
+					},
+				},
+			},
+		},
+	}
+}
+
+// addAPIKeyLifecycleSchema adds the lifecycle attributes to the schema s of an API key resource
+func addAPIKeyLifecycleSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for k, v := range apiKeyLifecycleSchema() {
+		s[k] = v
+	}
+	return s
+}
+
+// apiKeyLifecycleCustomizeDiff plans the rotation of the API key, the deletion of the previous
+// API key and the replacement of an API key whose expires_at has passed. computedKeys are the
+// attributes of the resource that change on rotation. Expiry is only enforced when Terraform
+// runs, IAM doesn't expire API keys.
+func apiKeyLifecycleCustomizeDiff(computedKeys ...string) schema.CustomizeDiffFunc {
+	return func(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		_, rotate := diff.GetOk("rotation")
+		if rotate && !diff.GetRawConfig().GetAttr("apikey").IsNull() {
+			return fmt.Errorf("[ERROR] apikey can't be set together with rotation, the rotated API keys are generated by IAM")
+		}
+
+		now := time.Now().UTC()
+		if diff.NewValueKnown("expires_at") && apiKeyExpired(diff, now) {
+			if diff.Id() == "" {
+				return fmt.Errorf("[ERROR] expires_at (%s) of the API key is in the past", diff.Get("expires_at").(string))
+			}
+			if err := diff.SetNew("expired", true); err != nil {
+				return err
+			}
+			return diff.ForceNew("expired")
+		}
+		if diff.Id() == "" {
+			return nil
+		}
+
+		if due, _ := apiKeyRotationDue(diff, now); due {
+			keys := append([]string{"apikey", "next_rotation_at", "previous_apikey_id", "previous_apikey_delete_at"}, computedKeys...)
+			for _, k := range keys {
+				if err := diff.SetNewComputed(k); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		nextRotation, err := apiKeyNextRotation(diff)
+		if err != nil {
+			return err
+		}
+		if nextRotation != diff.Get("next_rotation_at").(string) {
+			if err := diff.SetNew("next_rotation_at", nextRotation); err != nil {
+				return err
+			}
+		}
+		// The previous API key is deleted by the apply that follows its overlap window
+		if previousAPIKeyExpired(diff, now) {
+			if err := diff.SetNew("previous_apikey_id", ""); err != nil {
+				return err
+			}
+			if err := diff.SetNew("previous_apikey_delete_at", ""); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// apiKeyNextRotation returns the next rotation of the API key, which is rotation_days after
+// its creation, or an empty string if the API key is not rotated
+func apiKeyNextRotation(d apiKeyGetter) (string, error) {
+	rotation := d.Get("rotation").([]interface{})
+	createdAt := d.Get("created_at").(string)
+	if len(rotation) == 0 || rotation[0] == nil || createdAt == "" {
+		return "", nil
+	}
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error parsing creation date (%s) of API key: %s", createdAt, err)
+	}
+	days := rotation[0].(map[string]interface{})["rotation_days"].(int)
+	return created.AddDate(0, 0, days).UTC().Format(time.RFC3339), nil
+}
+
+// apiKeyRotationDue reports whether the API key has to be rotated at now
+func apiKeyRotationDue(d apiKeyGetter, now time.Time) (bool, error) {
+	nextRotation, err := apiKeyNextRotation(d)
+	if err != nil || nextRotation == "" {
+		return false, err
+	}
+	next, _ := time.Parse(time.RFC3339, nextRotation)
+	return !now.Before(next), nil
+}
+
+// apiKeyRotationPlanned reports whether the API key is rotated by the apply, the creation date
+// of the API key is unknown in the plan of a rotation
+func apiKeyRotationPlanned(d *schema.ResourceData) bool {
+	createdAt, _ := d.GetChange("created_at")
+	due, _ := apiKeyRotationDue(mapGetter{"rotation": d.Get("rotation"), "created_at": createdAt}, time.Now().UTC())
+	return due
+}
+
+// previousAPIKeyExpired reports whether the overlap window of the previous API key is over at now
+func previousAPIKeyExpired(d apiKeyGetter, now time.Time) bool {
+	if d.Get("previous_apikey_id").(string) == "" {
+		return false
+	}
+	deleteAt, err := time.Parse(time.RFC3339, d.Get("previous_apikey_delete_at").(string))
+	return err != nil || !now.Before(deleteAt)
+}
+
+// apiKeyExpired reports whether expires_at of the API key is over at now
+func apiKeyExpired(d apiKeyGetter, now time.Time) bool {
+	expiresAt, ok := d.Get("expires_at").(string)
+	if !ok || expiresAt == "" {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	return err == nil && !now.Before(expires)
+}
+
+// validateAPIKeyExpiry fails the creation of an API key that would be expired already, the
+// replacement of an expired API key fails until expires_at is moved or removed
+func validateAPIKeyExpiry(d *schema.ResourceData) error {
+	if apiKeyExpired(d, time.Now().UTC()) {
+		return fmt.Errorf("[ERROR] expires_at (%s) of the API key is in the past", d.Get("expires_at").(string))
+	}
+	return nil
+}
+
+// deleteIAMAPIKey unlocks and deletes the API key apiKeyID, a missing API key is ignored
+func deleteIAMAPIKey(iamIdentityClient *iamidentityv1.IamIdentityV1, apiKeyID string) error {
+	unlockAPIKeyOptions := &iamidentityv1.UnlockAPIKeyOptions{
+		ID: &apiKeyID,
+	}
+	response, err := iamIdentityClient.UnlockAPIKey(unlockAPIKeyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("[DEBUG] Error unlocking API key (%s): %s\n%s", apiKeyID, err, response)
+	}
+	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	}
+	response, err = iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting API key (%s): %s\n%s", apiKeyID, err, response)
+	}
+	return nil
+}
+
+// rotateIAMAPIKey creates a new API key with createAPIKeyOptions and makes it the API key of
+// the resource. The replaced API key is deleted after the overlap window of the rotation.
+func rotateIAMAPIKey(d *schema.ResourceData, meta interface{}, createAPIKeyOptions *iamidentityv1.CreateAPIKeyOptions) (*iamidentityv1.APIKey, error) {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return nil, err
+	}
+
+	// An API key that is still in its overlap window is replaced by the current API key
+	_, previous := d.GetChange("previous_apikey_id")
+	if previousID, _ := previous.(string); previousID != "" {
+		if err := deleteIAMAPIKey(iamIdentityClient, previousID); err != nil {
+			return nil, err
+		}
+	}
+
+	createAPIKeyOptions.Apikey = nil
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return nil, fmt.Errorf("[ERROR] Error rotating API key (%s): %s\n%s", d.Id(), err, response)
+	}
+	log.Printf("[INFO] Rotated API key (%s), the new API key is (%s)", d.Id(), *apiKey.ID)
+
+	currentID := d.Id()
+	rotation := d.Get("rotation").([]interface{})[0].(map[string]interface{})
+	overlap := rotation["overlap_hours"].(int)
+	if overlap == 0 {
+		if err := deleteIAMAPIKey(iamIdentityClient, currentID); err != nil {
+			return nil, err
+		}
+		d.Set("previous_apikey_id", "")
+		d.Set("previous_apikey_delete_at", "")
+	} else {
+		d.Set("previous_apikey_id", currentID)
+		d.Set("previous_apikey_delete_at", time.Now().UTC().Add(time.Duration(overlap)*time.Hour).Format(time.RFC3339))
+	}
+
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	return apiKey, nil
+}
+
+// deleteExpiredPreviousAPIKey deletes the previous API key once its overlap window is over
+func deleteExpiredPreviousAPIKey(d *schema.ResourceData, meta interface{}) error {
+	previousID, _ := d.GetChange("previous_apikey_id")
+	deleteAt, _ := d.GetChange("previous_apikey_delete_at")
+	previous := map[string]interface{}{
+		"previous_apikey_id":        previousID,
+		"previous_apikey_delete_at": deleteAt,
+	}
+	if !previousAPIKeyExpired(mapGetter(previous), time.Now().UTC()) {
+		return nil
+	}
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	if err := deleteIAMAPIKey(iamIdentityClient, previousID.(string)); err != nil {
+		return err
+	}
+	d.Set("previous_apikey_id", "")
+	d.Set("previous_apikey_delete_at", "")
+	return nil
+}
+
+// deleteAPIKeyLifecycle deletes the previous API key, and the secret of the API key unless a
+// replacing API key rotated it
+func deleteAPIKeyLifecycle(d *schema.ResourceData, meta interface{}) error {
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+		if err := deleteIAMAPIKey(iamIdentityClient, previousID); err != nil {
+			return err
+		}
+	}
+	if sm := d.Get("store_in_secrets_manager").([]interface{}); len(sm) > 0 && sm[0] != nil {
+		return deleteAPIKeySecret(meta, sm[0].(map[string]interface{}), d.Get("apikey").(string))
+	}
+	return nil
+}
+
+type mapGetter map[string]interface{}
+
+func (m mapGetter) Get(key string) interface{} {
+	return m[key]
+}
+
+// apiKeySecretsManagerClient returns a client for the Secrets Manager instance of the secret sm
+func apiKeySecretsManagerClient(meta interface{}, sm map[string]interface{}) (*secretsmanagerv1.SecretsManagerV1, string, error) {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, "", err
+	}
+	region := sm["region"].(string)
+	if region == "" {
+		bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+		if err != nil {
+			return nil, "", err
+		}
+		region = bluemixSession.Config.Region
+	}
+	instanceID := sm["instance_id"].(string)
+	smEndpointURL := "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	if sm["endpoint_type"].(string) == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	}
+	smUrl := conns.EnvFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)
+
+	// The client is shared, the URL is set on a copy
+	secretsManagerClient = secretsManagerClient.Clone()
+	if err := secretsManagerClient.SetServiceURL(smUrl); err != nil {
+		return nil, "", err
+	}
+	return secretsManagerClient, region, nil
+}
+
+// findAPIKeySecret returns the ID of the arbitrary secret named like the secret sm in its secret
+// group, or an empty string. The secret of a replaced API key is taken over by the new API key.
+func findAPIKeySecret(secretsManagerClient *secretsmanagerv1.SecretsManagerV1, sm map[string]interface{}) (string, error) {
+	name := sm["name"].(string)
+	groupID := sm["secret_group_id"].(string)
+	listAllSecretsOptions := &secretsmanagerv1.ListAllSecretsOptions{
+		Search: &name,
+	}
+	result, response, err := secretsManagerClient.ListAllSecrets(listAllSecretsOptions)
+	if err != nil || result == nil {
+		return "", fmt.Errorf("[ERROR] Error listing secrets of Secrets Manager instance (%s): %s\n%s", sm["instance_id"], err, response)
+	}
+	for _, r := range result.Resources {
+		secret, ok := r.(*secretsmanagerv1.SecretResource)
+		if !ok || secret.ID == nil || secret.Name == nil || *secret.Name != name {
+			continue
+		}
+		if secret.SecretType == nil || *secret.SecretType != secretsmanagerv1.SecretResourceSecretTypeArbitraryConst {
+			continue
+		}
+		secretGroupID := "default"
+		if secret.SecretGroupID != nil {
+			secretGroupID = *secret.SecretGroupID
+		}
+		if groupID == "" && secretGroupID == "default" || groupID == secretGroupID {
+			return *secret.ID, nil
+		}
+	}
+	return "", nil
+}
+
+// storeAPIKeyInSecretsManager creates the arbitrary secret holding the value of the API key, or
+// rotates the secret when the API key is rotated or replaced. A secret in another instance, or
+// with another name or group, is replaced.
+func storeAPIKeyInSecretsManager(d *schema.ResourceData, meta interface{}, apikey string, rotated bool) error {
+	o, n := d.GetChange("store_in_secrets_manager")
+	var old, sm map[string]interface{}
+	if l := o.([]interface{}); len(l) > 0 && l[0] != nil {
+		old = l[0].(map[string]interface{})
+	}
+	if l := n.([]interface{}); len(l) > 0 && l[0] != nil {
+		sm = l[0].(map[string]interface{})
+	}
+
+	if old != nil {
+		moved := sm == nil
+		for _, k := range []string{"instance_id", "region", "endpoint_type", "name", "secret_group_id"} {
+			moved = moved || (sm[k] != old[k] && !(k == "region" && sm[k] == ""))
+		}
+		if !moved && !rotated {
+			sm["region"] = old["region"]
+			sm["secret_id"] = old["secret_id"]
+			return d.Set("store_in_secrets_manager", []interface{}{sm})
+		}
+		if moved {
+			oldAPIKey, _ := d.GetChange("apikey")
+			if err := deleteAPIKeySecret(meta, old, oldAPIKey.(string)); err != nil {
+				return err
+			}
+		}
+	}
+	if sm == nil {
+		return nil
+	}
+	if apikey == "" {
+		return fmt.Errorf("[ERROR] The value of API key (%s) is not known, it can be stored in Secrets Manager when the API key is created or rotated", d.Id())
+	}
+
+	secretsManagerClient, region, err := apiKeySecretsManagerClient(meta, sm)
+	if err != nil {
+		return err
+	}
+	secretType := secretsmanagerv1.CreateSecretOptionsSecretTypeArbitraryConst
+
+	secretID, err := findAPIKeySecret(secretsManagerClient, sm)
+	if err != nil {
+		return err
+	}
+	if secretID == "" {
+		secret := &secretsmanagerv1.SecretResourceArbitrarySecretResource{
+			Name:    core.StringPtr(sm["name"].(string)),
+			Payload: core.StringPtr(apikey),
+		}
+		if description := sm["description"].(string); description != "" {
+			secret.Description = &description
+		}
+		if groupID := sm["secret_group_id"].(string); groupID != "" {
+			secret.SecretGroupID = &groupID
+		}
+		if labels := sm["labels"].(*schema.Set); labels.Len() > 0 {
+			secret.Labels = flex.ExpandStringList(labels.List())
+		}
+		if expiresAt := d.Get("expires_at").(string); expiresAt != "" {
+			expirationDate, err := core.ParseDateTime(expiresAt)
+			if err != nil {
+				return err
+			}
+			secret.ExpirationDate = &expirationDate
+		}
+		createSecretOptions := &secretsmanagerv1.CreateSecretOptions{
+			SecretType: &secretType,
+			Metadata: &secretsmanagerv1.CollectionMetadata{
+				CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst),
+				CollectionTotal: core.Int64Ptr(1),
+			},
+			Resources: []secretsmanagerv1.SecretResourceIntf{secret},
+		}
+		result, response, err := secretsManagerClient.CreateSecret(createSecretOptions)
+		if err != nil || result == nil || len(result.Resources) == 0 {
+			return fmt.Errorf("[ERROR] Error storing API key (%s) in Secrets Manager instance (%s): %s\n%s", d.Id(), sm["instance_id"], err, response)
+		}
+		if created, ok := result.Resources[0].(*secretsmanagerv1.SecretResource); ok && created.ID != nil {
+			secretID = *created.ID
+		}
+	} else {
+		updateSecretOptions := &secretsmanagerv1.UpdateSecretOptions{
+			SecretType: &secretType,
+			ID:         &secretID,
+			Action:     core.StringPtr(secretsmanagerv1.UpdateSecretOptionsActionRotateConst),
+			SecretActionOneOf: &secretsmanagerv1.SecretActionOneOfRotateArbitrarySecretBody{
+				Payload: &apikey,
+			},
+		}
+		_, response, err := secretsManagerClient.UpdateSecret(updateSecretOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error rotating secret (%s) of API key (%s): %s\n%s", secretID, d.Id(), err, response)
+		}
+	}
+
+	sm["region"] = region
+	sm["secret_id"] = secretID
+	return d.Set("store_in_secrets_manager", []interface{}{sm})
+}
+
+// arbitrarySecretPayload returns the payload of the arbitrary secret
+func arbitrarySecretPayload(secret *secretsmanagerv1.SecretResource) string {
+	if secret.Payload != nil {
+		return *secret.Payload
+	}
+	if secretData, ok := secret.SecretData.(map[string]interface{}); ok {
+		if payload, ok := secretData["payload"].(string); ok {
+			return payload
+		}
+	}
+	return ""
+}
+
+// deleteAPIKeySecret deletes the secret of the API key apikey, a missing secret or a secret that
+// holds another API key after a replacement is ignored
+func deleteAPIKeySecret(meta interface{}, sm map[string]interface{}, apikey string) error {
+	secretID := sm["secret_id"].(string)
+	if secretID == "" {
+		return nil
+	}
+	secretsManagerClient, _, err := apiKeySecretsManagerClient(meta, sm)
+	if err != nil {
+		return err
+	}
+	secretType := secretsmanagerv1.CreateSecretOptionsSecretTypeArbitraryConst
+
+	getSecretOptions := &secretsmanagerv1.GetSecretOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+	}
+	result, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+	if err != nil || result == nil || len(result.Resources) == 0 {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving secret (%s) of API key: %s\n%s", secretID, err, response)
+	}
+	if secret, ok := result.Resources[0].(*secretsmanagerv1.SecretResource); ok && arbitrarySecretPayload(secret) != apikey {
+		log.Printf("[INFO] Secret (%s) holds the API key that replaced this API key, it is not deleted", secretID)
+		return nil
+	}
+
+	deleteSecretOptions := &secretsmanagerv1.DeleteSecretOptions{
+		SecretType: &secretType,
+		ID:         &secretID,
+	}
+	response, err = secretsManagerClient.DeleteSecret(deleteSecretOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting secret (%s) of API key: %s\n%s", secretID, err, response)
+	}
+	return nil
+}
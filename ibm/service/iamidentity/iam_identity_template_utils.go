@@ -0,0 +1,737 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Trusted profile templates and account settings templates are not implemented by the
+// iamidentityv1 SDK, the requests are sent through the client of the IAM Identity API.
+// Both kinds of templates share their versioning and assignment model, the resources
+// are built from an iamIdentityTemplateKind.
+
+const (
+	iamIdentityAssignmentAccepted   = "accepted"
+	iamIdentityAssignmentInProgress = "in_progress"
+	iamIdentityAssignmentSucceeded  = "succeeded"
+	iamIdentityAssignmentFailed     = "failed"
+)
+
+type iamIdentityTemplate struct {
+	ID                       *string                              `json:"id,omitempty"`
+	Version                  *int64                               `json:"version,omitempty"`
+	AccountID                *string                              `json:"account_id,omitempty"`
+	Name                     *string                              `json:"name,omitempty"`
+	Description              *string                              `json:"description,omitempty"`
+	Committed                *bool                                `json:"committed,omitempty"`
+	Profile                  *iamTrustedProfileTemplateProfile    `json:"profile,omitempty"`
+	PolicyTemplateReferences []iamIdentityPolicyTemplateReference `json:"policy_template_references,omitempty"`
+	AccountSettings          *iamAccountSettingsTemplateSettings  `json:"account_settings,omitempty"`
+	EntityTag                *string                              `json:"entity_tag,omitempty"`
+	CRN                      *string                              `json:"crn,omitempty"`
+	Href                     *string                              `json:"href,omitempty"`
+	CreatedAt                *string                              `json:"created_at,omitempty"`
+	LastModifiedAt           *string                              `json:"last_modified_at,omitempty"`
+}
+
+type iamIdentityTemplateAssignment struct {
+	ID              *string                                 `json:"id,omitempty"`
+	AccountID       *string                                 `json:"account_id,omitempty"`
+	TemplateID      *string                                 `json:"template_id,omitempty"`
+	TemplateVersion *int64                                  `json:"template_version,omitempty"`
+	TargetType      *string                                 `json:"target_type,omitempty"`
+	Target          *string                                 `json:"target,omitempty"`
+	Status          *string                                 `json:"status,omitempty"`
+	Resources       []iamIdentityTemplateAssignmentResource `json:"resources,omitempty"`
+	EntityTag       *string                                 `json:"entity_tag,omitempty"`
+	Href            *string                                 `json:"href,omitempty"`
+	CreatedAt       *string                                 `json:"created_at,omitempty"`
+	LastModifiedAt  *string                                 `json:"last_modified_at,omitempty"`
+}
+
+// iamIdentityTemplateAssignmentResource is the trusted profile or the account settings
+// created in one account of the target
+type iamIdentityTemplateAssignmentResource struct {
+	Target          *string                                      `json:"target,omitempty"`
+	Profile         *iamIdentityTemplateAssignmentResourceDetail `json:"profile,omitempty"`
+	AccountSettings *iamIdentityTemplateAssignmentResourceDetail `json:"account_settings,omitempty"`
+}
+
+type iamIdentityTemplateAssignmentResourceDetail struct {
+	ID              *string `json:"id,omitempty"`
+	Version         *int64  `json:"version,omitempty"`
+	ResourceCreated *struct {
+		ID *string `json:"id,omitempty"`
+	} `json:"resource_created,omitempty"`
+	ErrorMessage *struct {
+		Name      *string `json:"name,omitempty"`
+		ErrorCode *string `json:"errorCode,omitempty"`
+		Message   *string `json:"message,omitempty"`
+	} `json:"error_message,omitempty"`
+	Status *string `json:"status,omitempty"`
+}
+
+// iamIdentityTemplateKind describes the API and the attributes of a kind of template
+type iamIdentityTemplateKind struct {
+	// name is used in messages, for example "trusted profile template"
+	name string
+	// templatesPath and assignmentsPath are the collections of the templates and assignments
+	templatesPath   string
+	assignmentsPath string
+	// schema returns the attributes of the templates of the kind
+	schema func() map[string]*schema.Schema
+	// expand sets the attributes of the kind on the template
+	expand func(d *schema.ResourceData, template *iamIdentityTemplate)
+	// flatten sets the attributes of the kind from the template
+	flatten func(d *schema.ResourceData, template *iamIdentityTemplate) error
+	// assignmentResource returns the resource of the kind created in an account
+	assignmentResource func(r iamIdentityTemplateAssignmentResource) *iamIdentityTemplateAssignmentResourceDetail
+}
+
+// resourceIBMIAMIdentityTemplate returns the resource of the templates of kind. A template
+// resource creates the template with its first version, a version resource creates a new
+// version of the template template_id.
+func resourceIBMIAMIdentityTemplate(kind *iamIdentityTemplateKind, version bool) *schema.Resource {
+	s := kind.schema()
+	s["description"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: fmt.Sprintf("The description of the %s version", kind.name),
+	}
+	s["committed"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Commits the template version, a committed version can't be changed and can be assigned",
+	}
+	s["version"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: fmt.Sprintf("The version of the %s", kind.name),
+	}
+	s["account_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fmt.Sprintf("The account of the %s", kind.name),
+	}
+	s["crn"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fmt.Sprintf("The CRN of the %s", kind.name),
+	}
+	s["entity_tag"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: fmt.Sprintf("The entity tag of the %s version", kind.name),
+	}
+	if version {
+		s["template_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The ID of the %s", kind.name),
+		}
+		s["name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the %s", kind.name),
+		}
+	} else {
+		s["template_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The ID of the %s", kind.name),
+		}
+		s["name"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("The name of the %s, unique in the account", kind.name),
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateCreate(context, d, meta, kind, version)
+		},
+		ReadContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateRead(context, d, meta, kind)
+		},
+		UpdateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateUpdate(context, d, meta, kind)
+		},
+		DeleteContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateDelete(context, d, meta, kind, version)
+		},
+		Importer: &schema.ResourceImporter{},
+		Schema:   s,
+	}
+}
+
+func resourceIBMIAMIdentityTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind, version bool) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template := &iamIdentityTemplate{}
+	kind.expand(d, template)
+	if description, ok := d.GetOk("description"); ok {
+		template.Description = core.StringPtr(description.(string))
+	}
+
+	result := &iamIdentityTemplate{}
+	var response *core.DetailedResponse
+	if version {
+		// The version inherits the name of the template
+		templateID := d.Get("template_id").(string)
+		response, err = iamIdentityTemplateRequest(context, iamIdentityClient, core.POST, kind.templatesPath+"/{template_id}/versions",
+			map[string]string{"template_id": templateID}, nil, "", template, result)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error creating version of %s (%s): %s\n%s", kind.name, templateID, err, response)
+		}
+	} else {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		template.Name = core.StringPtr(d.Get("name").(string))
+		template.AccountID = core.StringPtr(userDetails.UserAccount)
+		response, err = iamIdentityTemplateRequest(context, iamIdentityClient, core.POST, kind.templatesPath, nil, nil, "", template, result)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error creating %s: %s\n%s", kind.name, err, response)
+		}
+	}
+	if result.ID == nil || result.Version == nil {
+		return diag.Errorf("[ERROR] Error creating %s: no ID and version in response", kind.name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", *result.ID, *result.Version))
+
+	if d.Get("committed").(bool) {
+		err = commitIAMIdentityTemplateVersion(context, iamIdentityClient, kind, *result.ID, strconv.FormatInt(*result.Version, 10))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMIdentityTemplateRead(context, d, meta, kind)
+}
+
+func resourceIBMIAMIdentityTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, version, err := parseIAMIdentityTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, response, err := getIAMIdentityTemplateVersion(context, iamIdentityClient, kind, templateID, version)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing %s (%s) from state because it is not found", kind.name, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving %s (%s): %s\n%s", kind.name, d.Id(), err, response)
+	}
+
+	d.Set("template_id", templateID)
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("account_id", template.AccountID)
+	d.Set("crn", template.CRN)
+	d.Set("entity_tag", template.EntityTag)
+	if template.Version != nil {
+		d.Set("version", int(*template.Version))
+	}
+	if template.Committed != nil {
+		d.Set("committed", *template.Committed)
+	}
+	if err := kind.flatten(d, template); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceIBMIAMIdentityTemplateUpdate replaces the template version and commits it,
+// committed versions can't be changed
+func resourceIBMIAMIdentityTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, version, err := parseIAMIdentityTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldCommitted, _ := d.GetChange("committed")
+	if oldCommitted.(bool) {
+		return diag.Errorf("[ERROR] Version %s of %s %s is committed and can't be changed, create a new template version instead", version, kind.name, templateID)
+	}
+
+	if d.HasChangeExcept("committed") {
+		current, response, err := getIAMIdentityTemplateVersion(context, iamIdentityClient, kind, templateID, version)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving %s (%s): %s\n%s", kind.name, d.Id(), err, response)
+		}
+		template := &iamIdentityTemplate{
+			Name:        current.Name,
+			Description: core.StringPtr(d.Get("description").(string)),
+		}
+		kind.expand(d, template)
+		response, err = iamIdentityTemplateRequest(context, iamIdentityClient, core.PUT, kind.templatesPath+"/{template_id}/versions/{version}",
+			map[string]string{"template_id": templateID, "version": version}, nil, iamIdentityEntityTag(current, response), template, nil)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating %s (%s): %s\n%s", kind.name, d.Id(), err, response)
+		}
+	}
+
+	if d.Get("committed").(bool) {
+		err = commitIAMIdentityTemplateVersion(context, iamIdentityClient, kind, templateID, version)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMIdentityTemplateRead(context, d, meta, kind)
+}
+
+func resourceIBMIAMIdentityTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind, version bool) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, templateVersion, err := parseIAMIdentityTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the template deletes all of its versions
+	path := kind.templatesPath + "/{template_id}"
+	pathParams := map[string]string{"template_id": templateID}
+	if version {
+		path = kind.templatesPath + "/{template_id}/versions/{version}"
+		pathParams["version"] = templateVersion
+	}
+	response, err := iamIdentityTemplateRequest(context, iamIdentityClient, core.DELETE, path, pathParams, nil, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting %s (%s): %s\n%s", kind.name, d.Id(), err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getIAMIdentityTemplateVersion(context context.Context, client *iamidentityv1.IamIdentityV1, kind *iamIdentityTemplateKind, templateID, version string) (*iamIdentityTemplate, *core.DetailedResponse, error) {
+	result := &iamIdentityTemplate{}
+	response, err := iamIdentityTemplateRequest(context, client, core.GET, kind.templatesPath+"/{template_id}/versions/{version}",
+		map[string]string{"template_id": templateID, "version": version}, nil, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func commitIAMIdentityTemplateVersion(context context.Context, client *iamidentityv1.IamIdentityV1, kind *iamIdentityTemplateKind, templateID, version string) error {
+	current, response, err := getIAMIdentityTemplateVersion(context, client, kind, templateID, version)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving %s (%s/%s): %s\n%s", kind.name, templateID, version, err, response)
+	}
+	if current.Committed != nil && *current.Committed {
+		return nil
+	}
+	response, err = iamIdentityTemplateRequest(context, client, core.POST, kind.templatesPath+"/{template_id}/versions/{version}/commit",
+		map[string]string{"template_id": templateID, "version": version}, nil, iamIdentityEntityTag(current, response), nil, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error committing %s (%s/%s): %s\n%s", kind.name, templateID, version, err, response)
+	}
+	return nil
+}
+
+// iamIdentityEntityTag returns the entity tag of a template for the If-Match header
+func iamIdentityEntityTag(template *iamIdentityTemplate, response *core.DetailedResponse) string {
+	if etag := response.Headers.Get("ETag"); etag != "" {
+		return etag
+	}
+	if template.EntityTag != nil {
+		return *template.EntityTag
+	}
+	return "*"
+}
+
+// resourceIBMIAMIdentityTemplateAssignment returns the resource of the assignments of the templates of kind
+func resourceIBMIAMIdentityTemplateAssignment(kind *iamIdentityTemplateKind) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateAssignmentCreate(context, d, meta, kind)
+		},
+		ReadContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateAssignmentRead(context, d, meta, kind)
+		},
+		UpdateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateAssignmentUpdate(context, d, meta, kind)
+		},
+		DeleteContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceIBMIAMIdentityTemplateAssignmentDelete(context, d, meta, kind)
+		},
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"Account", "AccountGroup"}),
+				Description:  "The type of the target, Account for an enterprise account or AccountGroup for an enterprise account group",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise account or account group the template is assigned to",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The ID of the %s", kind.name),
+			},
+			"template_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: fmt.Sprintf("The committed version of the %s, updating it updates the accounts of the target", kind.name),
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise account that owns the assignment",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the assignment",
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources created in the accounts of the target",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the account",
+						},
+						"resource_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the resource created in the account",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the assignment in the account",
+						},
+						"error_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason the assignment failed in the account",
+						},
+					},
+				},
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The entity tag of the assignment",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href of the assignment",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the assignment was created",
+			},
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the assignment was last modified",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMIdentityTemplateAssignmentCreate(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignment := iamIdentityTemplateAssignment{
+		TemplateID:      core.StringPtr(d.Get("template_id").(string)),
+		TemplateVersion: core.Int64Ptr(int64(d.Get("template_version").(int))),
+		TargetType:      core.StringPtr(d.Get("target_type").(string)),
+		Target:          core.StringPtr(d.Get("target").(string)),
+	}
+	result := &iamIdentityTemplateAssignment{}
+	response, err := iamIdentityTemplateRequest(context, iamIdentityClient, core.POST, kind.assignmentsPath, nil, nil, "", assignment, result)
+	if err != nil || result.ID == nil {
+		return diag.Errorf("[ERROR] Error assigning %s (%s): %s\n%s", kind.name, d.Get("template_id").(string), err, response)
+	}
+
+	d.SetId(*result.ID)
+
+	_, err = waitForIAMIdentityTemplateAssignment(context, iamIdentityClient, kind, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMIdentityTemplateAssignmentRead(context, d, meta, kind)
+}
+
+func resourceIBMIAMIdentityTemplateAssignmentRead(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignment, response, err := getIAMIdentityTemplateAssignment(context, iamIdentityClient, kind, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing %s assignment (%s) from state because it is not found", kind.name, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving %s assignment (%s): %s\n%s", kind.name, d.Id(), err, response)
+	}
+
+	d.Set("target_type", assignment.TargetType)
+	d.Set("target", assignment.Target)
+	d.Set("template_id", assignment.TemplateID)
+	if assignment.TemplateVersion != nil {
+		d.Set("template_version", int(*assignment.TemplateVersion))
+	}
+	d.Set("account_id", assignment.AccountID)
+	d.Set("status", assignment.Status)
+	d.Set("resources", flattenIAMIdentityTemplateAssignmentResources(kind, assignment.Resources))
+	d.Set("entity_tag", assignment.EntityTag)
+	d.Set("href", assignment.Href)
+	d.Set("created_at", assignment.CreatedAt)
+	d.Set("last_modified_at", assignment.LastModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMIdentityTemplateAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("template_version") {
+		current, response, err := getIAMIdentityTemplateAssignment(context, iamIdentityClient, kind, d.Id())
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving %s assignment (%s): %s\n%s", kind.name, d.Id(), err, response)
+		}
+		etag := response.Headers.Get("ETag")
+		if etag == "" && current.EntityTag != nil {
+			etag = *current.EntityTag
+		}
+		body := map[string]interface{}{
+			"template_version": d.Get("template_version").(int),
+		}
+		response, err = iamIdentityTemplateRequest(context, iamIdentityClient, core.PATCH, kind.assignmentsPath+"/{assignment_id}",
+			map[string]string{"assignment_id": d.Id()}, nil, etag, body, nil)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating %s assignment (%s): %s\n%s", kind.name, d.Id(), err, response)
+		}
+		_, err = waitForIAMIdentityTemplateAssignment(context, iamIdentityClient, kind, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMIdentityTemplateAssignmentRead(context, d, meta, kind)
+}
+
+func resourceIBMIAMIdentityTemplateAssignmentDelete(context context.Context, d *schema.ResourceData, meta interface{}, kind *iamIdentityTemplateKind) diag.Diagnostics {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the assignment removes the resources created in the accounts of the target
+	response, err := iamIdentityTemplateRequest(context, iamIdentityClient, core.DELETE, kind.assignmentsPath+"/{assignment_id}",
+		map[string]string{"assignment_id": d.Id()}, nil, "", nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error deleting %s assignment (%s): %s\n%s", kind.name, d.Id(), err, response)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iamIdentityAssignmentAccepted, iamIdentityAssignmentInProgress, iamIdentityAssignmentSucceeded},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			assignment, response, err := getIAMIdentityTemplateAssignment(context, iamIdentityClient, kind, d.Id())
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return response, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving %s assignment (%s): %s\n%s", kind.name, d.Id(), err, response)
+			}
+			if assignment.Status != nil && *assignment.Status == iamIdentityAssignmentFailed {
+				return assignment, *assignment.Status, fmt.Errorf("[ERROR] Deleting %s assignment (%s) failed: %s", kind.name, d.Id(), iamIdentityTemplateAssignmentErrors(kind, assignment.Resources))
+			}
+			return assignment, iamIdentityAssignmentInProgress, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getIAMIdentityTemplateAssignment(context context.Context, client *iamidentityv1.IamIdentityV1, kind *iamIdentityTemplateKind, assignmentID string) (*iamIdentityTemplateAssignment, *core.DetailedResponse, error) {
+	result := &iamIdentityTemplateAssignment{}
+	response, err := iamIdentityTemplateRequest(context, client, core.GET, kind.assignmentsPath+"/{assignment_id}",
+		map[string]string{"assignment_id": assignmentID}, nil, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// waitForIAMIdentityTemplateAssignment waits until the template is applied to all the accounts of the target
+func waitForIAMIdentityTemplateAssignment(context context.Context, client *iamidentityv1.IamIdentityV1, kind *iamIdentityTemplateKind, assignmentID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iamIdentityAssignmentAccepted, iamIdentityAssignmentInProgress},
+		Target:  []string{iamIdentityAssignmentSucceeded},
+		Refresh: func() (interface{}, string, error) {
+			assignment, response, err := getIAMIdentityTemplateAssignment(context, client, kind, assignmentID)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving %s assignment (%s): %s\n%s", kind.name, assignmentID, err, response)
+			}
+			if assignment.Status == nil {
+				return assignment, iamIdentityAssignmentInProgress, nil
+			}
+			if *assignment.Status == iamIdentityAssignmentFailed {
+				return assignment, *assignment.Status, fmt.Errorf("[ERROR] %s assignment (%s) failed: %s", kind.name, assignmentID, iamIdentityTemplateAssignmentErrors(kind, assignment.Resources))
+			}
+			return assignment, *assignment.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func flattenIAMIdentityTemplateAssignmentResources(kind *iamIdentityTemplateKind, resources []iamIdentityTemplateAssignmentResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, r := range resources {
+		m := map[string]interface{}{
+			"target": r.Target,
+		}
+		if detail := kind.assignmentResource(r); detail != nil {
+			m["status"] = detail.Status
+			if detail.ResourceCreated != nil {
+				m["resource_id"] = detail.ResourceCreated.ID
+			}
+			if detail.ErrorMessage != nil && detail.ErrorMessage.Message != nil {
+				m["error_message"] = *detail.ErrorMessage.Message
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func iamIdentityTemplateAssignmentErrors(kind *iamIdentityTemplateKind, resources []iamIdentityTemplateAssignmentResource) string {
+	errors := []string{}
+	for _, r := range resources {
+		detail := kind.assignmentResource(r)
+		if detail == nil || detail.ErrorMessage == nil || detail.ErrorMessage.Message == nil {
+			continue
+		}
+		target := ""
+		if r.Target != nil {
+			target = *r.Target
+		}
+		errors = append(errors, fmt.Sprintf("%s: %s", target, *detail.ErrorMessage.Message))
+	}
+	return strings.Join(errors, ", ")
+}
+
+// parseIAMIdentityTemplateID splits an ID of the form <template_id>/<version>
+func parseIAMIdentityTemplateID(id string) (templateID, version string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of templateID/version", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func iamIdentityTemplateRequest(context context.Context, client *iamidentityv1.IamIdentityV1, method, path string, pathParams map[string]string, query map[string]string, etag string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if etag != "" {
+		builder.AddHeader("If-Match", etag)
+	}
+	for k, v := range query {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type iamAccountSettingsTemplateSettings struct {
+	RestrictCreateServiceID               *string `json:"restrict_create_service_id,omitempty"`
+	RestrictCreatePlatformApikey          *string `json:"restrict_create_platform_apikey,omitempty"`
+	AllowedIPAddresses                    *string `json:"allowed_ip_addresses,omitempty"`
+	Mfa                                   *string `json:"mfa,omitempty"`
+	SessionExpirationInSeconds            *string `json:"session_expiration_in_seconds,omitempty"`
+	SessionInvalidationInSeconds          *string `json:"session_invalidation_in_seconds,omitempty"`
+	MaxSessionsPerIdentity                *string `json:"max_sessions_per_identity,omitempty"`
+	SystemAccessTokenExpirationInSeconds  *string `json:"system_access_token_expiration_in_seconds,omitempty"`
+	SystemRefreshTokenExpirationInSeconds *string `json:"system_refresh_token_expiration_in_seconds,omitempty"`
+}
+
+var iamAccountSettingsTemplateKind = &iamIdentityTemplateKind{
+	name:            "account settings template",
+	templatesPath:   "/v1/account_settings_templates",
+	assignmentsPath: "/v1/account_settings_assignments",
+	schema:          iamAccountSettingsTemplateSchema,
+	expand:          expandIAMAccountSettingsTemplate,
+	flatten:         flattenIAMAccountSettingsTemplate,
+	assignmentResource: func(r iamIdentityTemplateAssignmentResource) *iamIdentityTemplateAssignmentResourceDetail {
+		return r.AccountSettings
+	},
+}
+
+// ResourceIBMIAMAccountSettingsTemplate creates an account settings template with its first version,
+// the template is assigned to the accounts of an enterprise by ibm_iam_account_settings_template_assignment
+func ResourceIBMIAMAccountSettingsTemplate() *schema.Resource {
+	return resourceIBMIAMIdentityTemplate(iamAccountSettingsTemplateKind, false)
+}
+
+func iamAccountSettingsTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_settings": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "The account settings that are applied to the accounts the template is assigned to",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"restrict_create_service_id": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ValidateAllowedStringValues([]string{"RESTRICTED", "NOT_RESTRICTED", "NOT_SET"}),
+						Description:  "Defines whether or not creating a service ID is access controlled, RESTRICTED, NOT_RESTRICTED or NOT_SET",
+					},
+					"restrict_create_platform_apikey": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ValidateAllowedStringValues([]string{"RESTRICTED", "NOT_RESTRICTED", "NOT_SET"}),
+						Description:  "Defines whether or not creating platform API keys is access controlled, RESTRICTED, NOT_RESTRICTED or NOT_SET",
+					},
+					"allowed_ip_addresses": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Defines the IP addresses and subnets from which IAM tokens can be created for the accounts",
+					},
+					"mfa": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validate.ValidateAllowedStringValues([]string{"NONE", "TOTP", "TOTP4ALL", "LEVEL1", "LEVEL2", "LEVEL3"}),
+						Description:  "Defines the MFA trait of the accounts, NONE, TOTP, TOTP4ALL, LEVEL1, LEVEL2 or LEVEL3",
+					},
+					"session_expiration_in_seconds": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Defines the session expiration in seconds, a whole number between 900 and 86400 or NOT_SET",
+					},
+					"session_invalidation_in_seconds": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Defines the period of time in seconds in which a session is invalidated due to inactivity, a whole number between 900 and 7200 or NOT_SET",
+					},
+					"max_sessions_per_identity": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Defines the max allowed sessions per identity, a whole number greater than 0 or NOT_SET",
+					},
+					"system_access_token_expiration_in_seconds": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Defines the access token expiration in seconds, a whole number between 900 and 3600 or NOT_SET",
+					},
+					"system_refresh_token_expiration_in_seconds": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Defines the refresh token expiration in seconds, a whole number between 900 and 259200 or NOT_SET",
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandIAMAccountSettingsTemplate(d *schema.ResourceData, template *iamIdentityTemplate) {
+	settings := &iamAccountSettingsTemplateSettings{}
+	if l := d.Get("account_settings").([]interface{}); len(l) > 0 && l[0] != nil {
+		s := l[0].(map[string]interface{})
+		if v := s["restrict_create_service_id"].(string); v != "" {
+			settings.RestrictCreateServiceID = core.StringPtr(v)
+		}
+		if v := s["restrict_create_platform_apikey"].(string); v != "" {
+			settings.RestrictCreatePlatformApikey = core.StringPtr(v)
+		}
+		if v := s["allowed_ip_addresses"].(string); v != "" {
+			settings.AllowedIPAddresses = core.StringPtr(v)
+		}
+		if v := s["mfa"].(string); v != "" {
+			settings.Mfa = core.StringPtr(v)
+		}
+		if v := s["session_expiration_in_seconds"].(string); v != "" {
+			settings.SessionExpirationInSeconds = core.StringPtr(v)
+		}
+		if v := s["session_invalidation_in_seconds"].(string); v != "" {
+			settings.SessionInvalidationInSeconds = core.StringPtr(v)
+		}
+		if v := s["max_sessions_per_identity"].(string); v != "" {
+			settings.MaxSessionsPerIdentity = core.StringPtr(v)
+		}
+		if v := s["system_access_token_expiration_in_seconds"].(string); v != "" {
+			settings.SystemAccessTokenExpirationInSeconds = core.StringPtr(v)
+		}
+		if v := s["system_refresh_token_expiration_in_seconds"].(string); v != "" {
+			settings.SystemRefreshTokenExpirationInSeconds = core.StringPtr(v)
+		}
+	}
+	template.AccountSettings = settings
+}
+
+func flattenIAMAccountSettingsTemplate(d *schema.ResourceData, template *iamIdentityTemplate) error {
+	settings := []map[string]interface{}{}
+	if template.AccountSettings != nil {
+		settings = append(settings, map[string]interface{}{
+			"restrict_create_service_id":                 template.AccountSettings.RestrictCreateServiceID,
+			"restrict_create_platform_apikey":            template.AccountSettings.RestrictCreatePlatformApikey,
+			"allowed_ip_addresses":                       template.AccountSettings.AllowedIPAddresses,
+			"mfa":                                        template.AccountSettings.Mfa,
+			"session_expiration_in_seconds":              template.AccountSettings.SessionExpirationInSeconds,
+			"session_invalidation_in_seconds":            template.AccountSettings.SessionInvalidationInSeconds,
+			"max_sessions_per_identity":                  template.AccountSettings.MaxSessionsPerIdentity,
+			"system_access_token_expiration_in_seconds":  template.AccountSettings.SystemAccessTokenExpirationInSeconds,
+			"system_refresh_token_expiration_in_seconds": template.AccountSettings.SystemRefreshTokenExpirationInSeconds,
+		})
+	}
+	return d.Set("account_settings", settings)
+}
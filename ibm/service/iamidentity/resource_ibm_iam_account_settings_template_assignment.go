@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAccountSettingsTemplateAssignment assigns a committed version of an account settings
+// template to an enterprise account or account group, the settings are applied to each account
+func ResourceIBMIAMAccountSettingsTemplateAssignment() *schema.Resource {
+	return resourceIBMIAMIdentityTemplateAssignment(iamAccountSettingsTemplateKind)
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMAccountSettingsTemplate_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMIdentityTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccountSettingsTemplateConfig(name, "TOTP", "3600", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "account_settings.0.mfa", "TOTP"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "account_settings.0.session_expiration_in_seconds", "3600"),
+					resource.TestCheckResourceAttrSet("ibm_iam_account_settings_template.template", "template_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccountSettingsTemplateConfig(name, "TOTP4ALL", "7200", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "committed", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "account_settings.0.mfa", "TOTP4ALL"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template.template", "account_settings.0.session_expiration_in_seconds", "7200"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_account_settings_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMAccountSettingsTemplateAssignment_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMIdentityTemplateAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccountSettingsTemplateAssignmentConfig(name, "ibm_iam_account_settings_template.template.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template_assignment.assignment", "template_version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template_assignment.assignment", "status", "succeeded"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccountSettingsTemplateAssignmentConfig(name, "ibm_iam_account_settings_template_version.version.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template_assignment.assignment", "template_version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings_template_assignment.assignment", "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMAccountSettingsTemplateConfig(name, mfa, sessionExpiration string, committed bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_account_settings_template" "template" {
+			name        = "%s"
+			description = "Account settings template for test scenario"
+			committed   = %t
+			account_settings {
+				mfa                           = "%s"
+				session_expiration_in_seconds = "%s"
+				restrict_create_service_id    = "RESTRICTED"
+			}
+		}
+	`, name, committed, mfa, sessionExpiration)
+}
+
+func testAccCheckIBMIAMAccountSettingsTemplateAssignmentConfig(name, templateVersion string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+
+		resource "ibm_enterprise_account_group" "account_group" {
+			parent                 = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name                   = "%[1]s"
+			primary_contact_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+
+		resource "ibm_iam_account_settings_template" "template" {
+			name      = "%[1]s"
+			committed = true
+			account_settings {
+				mfa = "TOTP"
+			}
+		}
+
+		resource "ibm_iam_account_settings_template_version" "version" {
+			template_id = ibm_iam_account_settings_template.template.template_id
+			committed   = true
+			account_settings {
+				mfa                             = "TOTP4ALL"
+				session_invalidation_in_seconds = "1800"
+			}
+		}
+
+		resource "ibm_iam_account_settings_template_assignment" "assignment" {
+			target_type      = "AccountGroup"
+			target           = ibm_enterprise_account_group.account_group.id
+			template_id      = ibm_iam_account_settings_template.template.template_id
+			template_version = %[2]s
+		}
+	`, name, templateVersion)
+}
@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAccountSettingsTemplateVersion creates a new version of an account settings template
+func ResourceIBMIAMAccountSettingsTemplateVersion() *schema.Resource {
+	return resourceIBMIAMIdentityTemplate(iamAccountSettingsTemplateKind, true)
+}
@@ -24,7 +24,9 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 		DeleteContext: resourceIbmIamApiKeyDelete,
 		Importer:      &schema.ResourceImporter{},
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: apiKeyLifecycleCustomizeDiff("apikey_id", "entity_tag", "crn", "locked", "created_at", "created_by", "modified_at"),
+
+		Schema: addAPIKeyLifecycleSchema(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -104,7 +106,7 @@ func ResourceIBMIAMApiKey() *schema.Resource {
 				Computed:    true,
 				Description: "If set contains a date time string of the last modification date in ISO format.",
 			},
-		},
+		}),
 	}
 }
 
@@ -113,12 +115,44 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := validateAPIKeyExpiry(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	createApiKeyOptions, err := iamApiKeyCreateOptions(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateApiKey failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	d.Set("expired", false)
+
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+
+	if err := storeAPIKeyInSecretsManager(d, meta, *apiKey.Apikey, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIbmIamApiKeyRead(context, d, meta)
+}
 
+func iamApiKeyCreateOptions(d *schema.ResourceData, meta interface{}) (*iamidentityv1.CreateAPIKeyOptions, error) {
 	createApiKeyOptions := &iamidentityv1.CreateAPIKeyOptions{}
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 	iamID := userDetails.UserID
 	accountID := userDetails.UserAccount
@@ -139,23 +173,7 @@ func resourceIbmIamApiKeyCreate(context context.Context, d *schema.ResourceData,
 	if _, ok := d.GetOk("locked"); ok {
 		createApiKeyOptions.SetEntityLock(d.Get("locked").(string))
 	}
-
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createApiKeyOptions)
-	if err != nil {
-		log.Printf("[DEBUG] CreateApiKey failed %s\n%s", err, response)
-		return diag.FromErr(err)
-	}
-
-	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
-
-	if keyfile, ok := d.GetOk("file"); ok {
-		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
-			log.Printf("Error writing API Key Details to file: %s", err)
-		}
-	}
-
-	return resourceIbmIamApiKeyRead(context, d, meta)
+	return createApiKeyOptions, nil
 }
 
 func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -211,6 +229,13 @@ func resourceIbmIamApiKeyRead(context context.Context, d *schema.ResourceData, m
 	if err = d.Set("modified_at", apiKey.ModifiedAt.String()); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error setting modified_at: %s", err))
 	}
+	nextRotation, err := apiKeyNextRotation(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("next_rotation_at", nextRotation); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting next_rotation_at: %s", err))
+	}
 
 	return nil
 }
@@ -221,6 +246,34 @@ func resourceIbmIamApiKeyUpdate(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if apiKeyRotationPlanned(d) {
+		createApiKeyOptions, err := iamApiKeyCreateOptions(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		apiKey, err := rotateIAMAPIKey(d, meta, createApiKeyOptions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if keyfile, ok := d.GetOk("file"); ok {
+			if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+				log.Printf("Error writing API Key Details to file: %s", err)
+			}
+		}
+		if err := storeAPIKeyInSecretsManager(d, meta, *apiKey.Apikey, true); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceIbmIamApiKeyRead(context, d, meta)
+	}
+	if err := deleteExpiredPreviousAPIKey(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("store_in_secrets_manager") {
+		if err := storeAPIKeyInSecretsManager(d, meta, d.Get("apikey").(string), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	updateApiKeyOptions := &iamidentityv1.UpdateAPIKeyOptions{}
 
 	updateApiKeyOptions.SetIfMatch("*")
@@ -244,6 +297,10 @@ func resourceIbmIamApiKeyDelete(context context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	if err := deleteAPIKeyLifecycle(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	deleteApiKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{}
 
 	deleteApiKeyOptions.SetID(d.Id())
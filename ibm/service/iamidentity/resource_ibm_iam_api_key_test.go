@@ -6,6 +6,7 @@ package iamidentity_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
@@ -84,6 +85,38 @@ func TestAccIbmIamApiKeyAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIbmIamApiKeyRotation(t *testing.T) {
+	var conf iamidentityv1.APIKey
+	name := fmt.Sprintf("name_%d", acctest.RandIntRange(10, 100))
+	expiresAt := time.Now().UTC().Add(72 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmIamApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmIamApiKeyConfigRotation(name, expiresAt, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmIamApiKeyExists("ibm_iam_api_key.iam_api_key", conf),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "expires_at", expiresAt),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "expired", "false"),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "rotation.0.rotation_days", "30"),
+					resource.TestCheckResourceAttrSet("ibm_iam_api_key.iam_api_key", "next_rotation_at"),
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "previous_apikey_id", ""),
+				),
+			},
+			{
+				Config: testAccCheckIbmIamApiKeyConfigRotation(name, expiresAt, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_api_key.iam_api_key", "rotation.0.rotation_days", "60"),
+					resource.TestCheckResourceAttrSet("ibm_iam_api_key.iam_api_key", "next_rotation_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIbmIamApiKeyConfigBasic(name string) string {
 	return fmt.Sprintf(`
 
@@ -104,6 +137,19 @@ func testAccCheckIbmIamApiKeyConfig(name string, description string, storeValue
 	`, name, description, storeValue)
 }
 
+func testAccCheckIbmIamApiKeyConfigRotation(name, expiresAt string, rotationDays int) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_api_key" "iam_api_key" {
+			name       = "%s"
+			expires_at = "%s"
+			rotation {
+				rotation_days = %d
+				overlap_hours = 2
+			}
+		}
+	`, name, expiresAt, rotationDays)
+}
+
 func testAccCheckIbmIamApiKeyExists(n string, obj iamidentityv1.APIKey) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
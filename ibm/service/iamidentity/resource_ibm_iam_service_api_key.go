@@ -4,6 +4,7 @@
 package iamidentity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -13,6 +14,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -26,7 +28,12 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 		Exists:   resourceIBMIAMServiceAPIKeyExists,
 		Importer: &schema.ResourceImporter{},
 
-		Schema: map[string]*schema.Schema{
+		CustomizeDiff: customdiff.Sequence(
+			resourceIBMIAMServiceAPIKeyApikeyDiff,
+			apiKeyLifecycleCustomizeDiff("crn", "entity_tag", "created_by", "created_at", "modified_at"),
+		),
+
+		Schema: addAPIKeyLifecycleSchema(map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -58,7 +65,6 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Sensitive:   true,
-				ForceNew:    true,
 				Description: "API key value for this API key",
 			},
 
@@ -112,10 +118,19 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Computed:    true,
 				Description: "The date and time Service API Key was modified",
 			},
-		},
+		}),
 	}
 }
 
+// resourceIBMIAMServiceAPIKeyApikeyDiff replaces the API key when the configured apikey changes,
+// a rotation changes the apikey in place
+func resourceIBMIAMServiceAPIKeyApikeyDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("apikey") && !diff.GetRawConfig().GetAttr("apikey").IsNull() {
+		return diff.ForceNew("apikey")
+	}
+	return nil
+}
+
 type APIKey struct {
 	Name        string
 	Description string
@@ -129,7 +144,38 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	if err := validateAPIKeyExpiry(d); err != nil {
+		return err
+	}
+
+	createAPIKeyOptions, err := serviceAPIKeyCreateOptions(d, meta)
+	if err != nil {
+		return err
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
+	}
+
+	d.SetId(*apiKey.ID)
+	d.Set("apikey", *apiKey.Apikey)
+	d.Set("expired", false)
+
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+
+	if err := storeAPIKeyInSecretsManager(d, meta, *apiKey.Apikey, false); err != nil {
+		return err
+	}
+
+	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+}
 
+func serviceAPIKeyCreateOptions(d *schema.ResourceData, meta interface{}) (*iamidentityv1.CreateAPIKeyOptions, error) {
 	name := d.Get("name").(string)
 	iamID := d.Get("iam_service_id").(string)
 
@@ -145,7 +191,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 
 	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	createAPIKeyOptions.AccountID = &userDetails.UserAccount
 
@@ -163,22 +209,7 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		elockstr := strconv.FormatBool(lock.(bool))
 		createAPIKeyOptions.EntityLock = &elockstr
 	}
-
-	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
-	if err != nil || apiKey == nil {
-		return fmt.Errorf("[DEBUG] Service API Key creation Error: %s\n%s", err, response)
-	}
-
-	d.SetId(*apiKey.ID)
-	d.Set("apikey", *apiKey.Apikey)
-
-	if keyfile, ok := d.GetOk("file"); ok {
-		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
-			log.Printf("Error writing API Key Details to file: %s", err)
-		}
-	}
-
-	return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	return createAPIKeyOptions, nil
 }
 
 func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) error {
@@ -233,6 +264,11 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if apiKey.ModifiedAt != nil {
 		d.Set("modified_at", apiKey.ModifiedAt.String())
 	}
+	nextRotation, err := apiKeyNextRotation(d)
+	if err != nil {
+		return err
+	}
+	d.Set("next_rotation_at", nextRotation)
 
 	return nil
 }
@@ -243,6 +279,35 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+
+	if apiKeyRotationPlanned(d) {
+		createAPIKeyOptions, err := serviceAPIKeyCreateOptions(d, meta)
+		if err != nil {
+			return err
+		}
+		apiKey, err := rotateIAMAPIKey(d, meta, createAPIKeyOptions)
+		if err != nil {
+			return err
+		}
+		if keyfile, ok := d.GetOk("file"); ok {
+			if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+				log.Printf("Error writing API Key Details to file: %s", err)
+			}
+		}
+		if err := storeAPIKeyInSecretsManager(d, meta, *apiKey.Apikey, true); err != nil {
+			return err
+		}
+		return resourceIBMIAMServiceAPIKeyRead(d, meta)
+	}
+	if err := deleteExpiredPreviousAPIKey(d, meta); err != nil {
+		return err
+	}
+	if d.HasChange("store_in_secrets_manager") {
+		if err := storeAPIKeyInSecretsManager(d, meta, d.Get("apikey").(string), false); err != nil {
+			return err
+		}
+	}
+
 	apiKeyID := d.Id()
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
@@ -303,6 +368,10 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[DEBUG] Error retrieving Service API Key: %s\n%s", err, response)
 	}
 
+	if err := deleteAPIKeyLifecycle(d, meta); err != nil {
+		return err
+	}
+
 	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
 		ID: &apiKeyID,
 	}
@@ -82,6 +82,39 @@ func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_SecretsManager(t *testing.T) {
+	var apiKey string
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_service_api_key.testacc_apiKey"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeySecretsManager(serviceName, name, acc.SecretsManagerInstanceID, "terraform-apikey"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyExists(resourceName, apiKey),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.rotation_days", "7"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_at"),
+					resource.TestCheckResourceAttrSet(resourceName, "store_in_secrets_manager.0.secret_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_secrets_manager_secret.apikey", "payload", resourceName, "apikey"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeySecretsManager(serviceName, name, acc.SecretsManagerInstanceID, "terraform-apikey-moved"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "store_in_secrets_manager.0.name", "terraform-apikey-moved"),
+					resource.TestCheckResourceAttrSet(resourceName, "store_in_secrets_manager.0.secret_id"),
+					resource.TestCheckResourceAttrPair("data.ibm_secrets_manager_secret.apikey", "payload", resourceName, "apikey"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMServiceAPIKeyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -191,3 +224,29 @@ func testAccCheckIBMIAMServiceAPIKeyImport(serviceName, name string) string {
 	  	}
 	`, serviceName, name)
 }
+
+func testAccCheckIBMIAMServiceAPIKeySecretsManager(serviceName, name, instanceID, secretName string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+		resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name           = "%s"
+			iam_service_id = ibm_iam_service_id.serviceID.iam_id
+			rotation {
+				rotation_days = 7
+			}
+			store_in_secrets_manager {
+				instance_id = "%s"
+				name        = "%s"
+				labels      = ["terraform"]
+			}
+		}
+		data "ibm_secrets_manager_secret" "apikey" {
+			instance_id = "%s"
+			secret_type = "arbitrary"
+			secret_id   = ibm_iam_service_api_key.testacc_apiKey.store_in_secrets_manager[0].secret_id
+		}
+	`, serviceName, name, instanceID, secretName, instanceID)
+}
@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type iamTrustedProfileTemplateProfile struct {
+	Name        *string                             `json:"name"`
+	Description *string                             `json:"description,omitempty"`
+	Rules       []iamTrustedProfileTemplateRule     `json:"rules,omitempty"`
+	Identities  []iamTrustedProfileTemplateIdentity `json:"identities,omitempty"`
+}
+
+type iamTrustedProfileTemplateRule struct {
+	Name       *string                              `json:"name,omitempty"`
+	Type       *string                              `json:"type"`
+	RealmName  *string                              `json:"realm_name,omitempty"`
+	Expiration *int64                               `json:"expiration,omitempty"`
+	Conditions []iamTrustedProfileTemplateCondition `json:"conditions"`
+}
+
+type iamTrustedProfileTemplateCondition struct {
+	Claim    *string `json:"claim"`
+	Operator *string `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+type iamTrustedProfileTemplateIdentity struct {
+	IamID       *string  `json:"iam_id"`
+	Identifier  *string  `json:"identifier"`
+	Type        *string  `json:"type"`
+	Accounts    []string `json:"accounts,omitempty"`
+	Description *string  `json:"description,omitempty"`
+}
+
+type iamIdentityPolicyTemplateReference struct {
+	ID      *string `json:"id"`
+	Version *string `json:"version"`
+}
+
+var iamTrustedProfileTemplateKind = &iamIdentityTemplateKind{
+	name:            "trusted profile template",
+	templatesPath:   "/v1/profile_templates",
+	assignmentsPath: "/v1/profile_assignments",
+	schema:          iamTrustedProfileTemplateSchema,
+	expand:          expandIAMTrustedProfileTemplate,
+	flatten:         flattenIAMTrustedProfileTemplate,
+	assignmentResource: func(r iamIdentityTemplateAssignmentResource) *iamIdentityTemplateAssignmentResourceDetail {
+		return r.Profile
+	},
+}
+
+// ResourceIBMIAMTrustedProfileTemplate creates a trusted profile template with its first version,
+// the template is assigned to the accounts of an enterprise by ibm_iam_trusted_profile_template_assignment
+func ResourceIBMIAMTrustedProfileTemplate() *schema.Resource {
+	return resourceIBMIAMIdentityTemplate(iamTrustedProfileTemplateKind, false)
+}
+
+func iamTrustedProfileTemplateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"profile": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "The trusted profile that is created in the accounts the template is assigned to",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the trusted profile",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Description of the trusted profile",
+					},
+					"rules": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Claim rules of the trusted profile",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "Name of the claim rule",
+								},
+								"type": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validate.ValidateAllowedStringValues([]string{"Profile-SAML"}),
+									Description:  "Type of the claim rule, Profile-SAML",
+								},
+								"realm_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "The realm name of the Idp this claim rule applies to",
+								},
+								"expiration": {
+									Type:        schema.TypeInt,
+									Optional:    true,
+									Description: "Session expiration in seconds",
+								},
+								"conditions": {
+									Type:        schema.TypeList,
+									Required:    true,
+									Description: "Conditions of the claim rule",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"claim": {
+												Type:        schema.TypeString,
+												Required:    true,
+												Description: "The claim to evaluate against",
+											},
+											"operator": {
+												Type:         schema.TypeString,
+												Required:     true,
+												ValidateFunc: validate.ValidateAllowedStringValues([]string{"EQUALS", "NOT_EQUALS", "EQUALS_IGNORE_CASE", "NOT_EQUALS_IGNORE_CASE", "CONTAINS", "IN"}),
+												Description:  "The operation to perform on the claim. valid values are EQUALS, NOT_EQUALS, EQUALS_IGNORE_CASE, NOT_EQUALS_IGNORE_CASE, CONTAINS, IN.",
+											},
+											"value": {
+												Type:        schema.TypeString,
+												Required:    true,
+												Description: "The stringified JSON value that the claim is compared to using the operator",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"identities": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Identities that can assume the trusted profile",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"iam_id": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "IAM ID of the identity",
+								},
+								"identifier": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "Identifier of the identity, the IAM ID of a user or service ID or the CRN of a compute resource",
+								},
+								"type": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validate.ValidateAllowedStringValues([]string{"user", "serviceid", "crn"}),
+									Description:  "Type of the identity, user, serviceid or crn",
+								},
+								"accounts": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "Accounts of the identity, only for identities of type user",
+								},
+								"description": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									Description: "Description of the identity",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"policy_template_references": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Policy templates that are assigned to the trusted profile",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "ID of the policy template",
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Version of the policy template",
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandIAMTrustedProfileTemplate(d *schema.ResourceData, template *iamIdentityTemplate) {
+	p := d.Get("profile").([]interface{})[0].(map[string]interface{})
+	profile := &iamTrustedProfileTemplateProfile{
+		Name: core.StringPtr(p["name"].(string)),
+	}
+	if description := p["description"].(string); description != "" {
+		profile.Description = core.StringPtr(description)
+	}
+	for _, r := range p["rules"].([]interface{}) {
+		rule := r.(map[string]interface{})
+		templateRule := iamTrustedProfileTemplateRule{
+			Type:       core.StringPtr(rule["type"].(string)),
+			Conditions: []iamTrustedProfileTemplateCondition{},
+		}
+		if name := rule["name"].(string); name != "" {
+			templateRule.Name = core.StringPtr(name)
+		}
+		if realmName := rule["realm_name"].(string); realmName != "" {
+			templateRule.RealmName = core.StringPtr(realmName)
+		}
+		if expiration := rule["expiration"].(int); expiration != 0 {
+			templateRule.Expiration = core.Int64Ptr(int64(expiration))
+		}
+		for _, c := range rule["conditions"].([]interface{}) {
+			condition := c.(map[string]interface{})
+			templateRule.Conditions = append(templateRule.Conditions, iamTrustedProfileTemplateCondition{
+				Claim:    core.StringPtr(condition["claim"].(string)),
+				Operator: core.StringPtr(condition["operator"].(string)),
+				Value:    core.StringPtr(condition["value"].(string)),
+			})
+		}
+		profile.Rules = append(profile.Rules, templateRule)
+	}
+	for _, i := range p["identities"].([]interface{}) {
+		identity := i.(map[string]interface{})
+		templateIdentity := iamTrustedProfileTemplateIdentity{
+			IamID:      core.StringPtr(identity["iam_id"].(string)),
+			Identifier: core.StringPtr(identity["identifier"].(string)),
+			Type:       core.StringPtr(identity["type"].(string)),
+			Accounts:   flex.ExpandStringList(identity["accounts"].([]interface{})),
+		}
+		if description := identity["description"].(string); description != "" {
+			templateIdentity.Description = core.StringPtr(description)
+		}
+		profile.Identities = append(profile.Identities, templateIdentity)
+	}
+	template.Profile = profile
+
+	for _, r := range d.Get("policy_template_references").([]interface{}) {
+		reference := r.(map[string]interface{})
+		template.PolicyTemplateReferences = append(template.PolicyTemplateReferences, iamIdentityPolicyTemplateReference{
+			ID:      core.StringPtr(reference["id"].(string)),
+			Version: core.StringPtr(reference["version"].(string)),
+		})
+	}
+}
+
+func flattenIAMTrustedProfileTemplate(d *schema.ResourceData, template *iamIdentityTemplate) error {
+	profiles := []map[string]interface{}{}
+	if template.Profile != nil {
+		rules := []map[string]interface{}{}
+		for _, r := range template.Profile.Rules {
+			conditions := []map[string]interface{}{}
+			for _, c := range r.Conditions {
+				conditions = append(conditions, map[string]interface{}{
+					"claim":    c.Claim,
+					"operator": c.Operator,
+					"value":    c.Value,
+				})
+			}
+			rule := map[string]interface{}{
+				"name":       r.Name,
+				"type":       r.Type,
+				"realm_name": r.RealmName,
+				"conditions": conditions,
+			}
+			if r.Expiration != nil {
+				rule["expiration"] = int(*r.Expiration)
+			}
+			rules = append(rules, rule)
+		}
+		identities := []map[string]interface{}{}
+		for _, i := range template.Profile.Identities {
+			identities = append(identities, map[string]interface{}{
+				"iam_id":      i.IamID,
+				"identifier":  i.Identifier,
+				"type":        i.Type,
+				"accounts":    i.Accounts,
+				"description": i.Description,
+			})
+		}
+		profiles = append(profiles, map[string]interface{}{
+			"name":        template.Profile.Name,
+			"description": template.Profile.Description,
+			"rules":       rules,
+			"identities":  identities,
+		})
+	}
+	if err := d.Set("profile", profiles); err != nil {
+		return err
+	}
+
+	references := []map[string]interface{}{}
+	for _, r := range template.PolicyTemplateReferences {
+		references = append(references, map[string]interface{}{
+			"id":      r.ID,
+			"version": r.Version,
+		})
+	}
+	return d.Set("policy_template_references", references)
+}
@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMTrustedProfileTemplateAssignment assigns a committed version of a trusted profile
+// template to an enterprise account or account group, the trusted profile is created in each account
+func ResourceIBMIAMTrustedProfileTemplateAssignment() *schema.Resource {
+	return resourceIBMIAMIdentityTemplateAssignment(iamTrustedProfileTemplateKind)
+}
@@ -0,0 +1,265 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfileTemplate_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMIdentityTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileTemplateConfig(name, "Profile for test scenario1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "committed", "false"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "profile.0.description", "Profile for test scenario1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "profile.0.rules.0.conditions.0.operator", "EQUALS"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_template.template", "template_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfileTemplateConfig(name, "Profile for test scenario2", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "committed", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template.template", "profile.0.description", "Profile for test scenario2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_trusted_profile_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMTrustedProfileTemplateVersion_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMIdentityTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileTemplateVersionConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_version.version", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_version.version", "version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_version.version", "profile.0.identities.#", "1"),
+					resource.TestCheckResourceAttrPair("ibm_iam_trusted_profile_template_version.version", "template_id", "ibm_iam_trusted_profile_template.template", "template_id"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_trusted_profile_template_version.version",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMTrustedProfileTemplateAssignment_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMIdentityTemplateAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileTemplateAssignmentConfig(name, "ibm_iam_trusted_profile_template.template.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_assignment.assignment", "target_type", "AccountGroup"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_assignment.assignment", "template_version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_assignment.assignment", "status", "succeeded"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfileTemplateAssignmentConfig(name, "ibm_iam_trusted_profile_template_version.version.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_assignment.assignment", "template_version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_template_assignment.assignment", "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMIdentityTemplateDestroy(s *terraform.State) error {
+	paths := map[string]string{
+		"ibm_iam_trusted_profile_template":          "/v1/profile_templates",
+		"ibm_iam_trusted_profile_template_version":  "/v1/profile_templates",
+		"ibm_iam_account_settings_template":         "/v1/account_settings_templates",
+		"ibm_iam_account_settings_template_version": "/v1/account_settings_templates",
+	}
+	for _, rs := range s.RootModule().Resources {
+		path, ok := paths[rs.Type]
+		if !ok {
+			continue
+		}
+		parts := strings.Split(rs.Primary.ID, "/")
+		statusCode, err := testAccIBMIAMIdentityTemplateStatus(fmt.Sprintf("%s/%s/versions/%s", path, parts[0], parts[1]))
+		if err == nil {
+			return fmt.Errorf("Template still exists: %s", rs.Primary.ID)
+		}
+		if statusCode != 404 {
+			return fmt.Errorf("[ERROR] Error waiting for template (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMIAMIdentityTemplateAssignmentDestroy(s *terraform.State) error {
+	paths := map[string]string{
+		"ibm_iam_trusted_profile_template_assignment":  "/v1/profile_assignments",
+		"ibm_iam_account_settings_template_assignment": "/v1/account_settings_assignments",
+	}
+	for _, rs := range s.RootModule().Resources {
+		path, ok := paths[rs.Type]
+		if !ok {
+			continue
+		}
+		statusCode, err := testAccIBMIAMIdentityTemplateStatus(fmt.Sprintf("%s/%s", path, rs.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("Template assignment still exists: %s", rs.Primary.ID)
+		}
+		if statusCode != 404 {
+			return fmt.Errorf("[ERROR] Error waiting for template assignment (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+// testAccIBMIAMIdentityTemplateStatus returns the status code of a GET request
+// on path of the IAM Identity API
+func testAccIBMIAMIdentityTemplateStatus(path string) (int, error) {
+	iamIdentityClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return 0, err
+	}
+	builder := core.NewRequestBuilder(core.GET)
+	if _, err = builder.ResolveRequestURL(iamIdentityClient.Service.Options.URL, path, nil); err != nil {
+		return 0, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	if err != nil {
+		return 0, err
+	}
+	response, err := iamIdentityClient.Service.Request(request, nil)
+	if response == nil {
+		return 0, err
+	}
+	return response.StatusCode, err
+}
+
+func testAccCheckIBMIAMTrustedProfileTemplateConfig(name, description string, committed bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile_template" "template" {
+			name        = "%s"
+			description = "Trusted profile template for test scenario"
+			committed   = %t
+			profile {
+				name        = "terraform-profile"
+				description = "%s"
+				rules {
+					name       = "rule"
+					type       = "Profile-SAML"
+					realm_name = "https://w3id.sso.ibm.com/auth/sps/samlidp2/saml20"
+					expiration = 43200
+					conditions {
+						claim    = "blueGroups"
+						operator = "EQUALS"
+						value    = "\"cloud-docs-dev\""
+					}
+				}
+			}
+		}
+	`, name, committed, description)
+}
+
+func testAccCheckIBMIAMTrustedProfileTemplateVersionConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "service_id" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_trusted_profile_template" "template" {
+			name      = "%[1]s"
+			committed = true
+			profile {
+				name = "terraform-profile"
+			}
+		}
+
+		resource "ibm_iam_trusted_profile_template_version" "version" {
+			template_id = ibm_iam_trusted_profile_template.template.template_id
+			description = "Second version of the trusted profile template"
+			profile {
+				name = "terraform-profile"
+				identities {
+					iam_id     = ibm_iam_service_id.service_id.iam_id
+					identifier = ibm_iam_service_id.service_id.id
+					type       = "serviceid"
+				}
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMTrustedProfileTemplateAssignmentConfig(name, templateVersion string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+
+		resource "ibm_enterprise_account_group" "account_group" {
+			parent                 = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name                   = "%[1]s"
+			primary_contact_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+
+		resource "ibm_iam_trusted_profile_template" "template" {
+			name      = "%[1]s"
+			committed = true
+			profile {
+				name = "terraform-profile"
+			}
+		}
+
+		resource "ibm_iam_trusted_profile_template_version" "version" {
+			template_id = ibm_iam_trusted_profile_template.template.template_id
+			committed   = true
+			profile {
+				name        = "terraform-profile"
+				description = "Second version"
+			}
+		}
+
+		resource "ibm_iam_trusted_profile_template_assignment" "assignment" {
+			target_type      = "AccountGroup"
+			target           = ibm_enterprise_account_group.account_group.id
+			template_id      = ibm_iam_trusted_profile_template.template.template_id
+			template_version = %[2]s
+		}
+	`, name, templateVersion)
+}
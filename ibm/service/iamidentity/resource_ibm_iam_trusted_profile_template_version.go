@@ -0,0 +1,13 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iamidentity
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMTrustedProfileTemplateVersion creates a new version of a trusted profile template
+func ResourceIBMIAMTrustedProfileTemplateVersion() *schema.Resource {
+	return resourceIBMIAMIdentityTemplate(iamTrustedProfileTemplateKind, true)
+}
@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source to find the policy template assignments of an enterprise and their status in each account
+func DataSourceIBMIAMPolicyAssignments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMPolicyAssignmentsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The enterprise account of the assignments, defaults to the account of the provider",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"template_id": {
+				Description: "Lists the assignments of this policy template",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"template_version": {
+				Description:  "Lists the assignments of this version of the policy template",
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"template_id"},
+			},
+			"assignments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The policy template assignments",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the assignment",
+						},
+						"target_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the target, Account or AccountGroup",
+						},
+						"target_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the enterprise account or account group",
+						},
+						"template_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the policy template",
+						},
+						"template_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The version of the policy template",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the assignment",
+						},
+						"resources": iamPolicyAssignmentResourcesSchema(),
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the assignment was created",
+						},
+						"last_modified_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the assignment was last modified",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMPolicyAssignmentsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.UserAccount
+	}
+	query := map[string]string{
+		"version":    iamPolicyAssignmentAPIVersion,
+		"account_id": accountID,
+	}
+	if templateID, ok := d.GetOk("template_id"); ok {
+		query["template_id"] = templateID.(string)
+	}
+	if templateVersion, ok := d.GetOk("template_version"); ok {
+		query["template_version"] = templateVersion.(string)
+	}
+
+	assignments := []iamPolicyAssignment{}
+	for {
+		result := &iamPolicyAssignmentCollection{}
+		response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.GET, "/v1/policy_assignments", nil, query, "", nil, result)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error listing policy assignments: %s\n%s", err, response)
+		}
+		assignments = append(assignments, result.Assignments...)
+		if result.Next == nil || result.Next.Start == nil || *result.Next.Start == "" {
+			break
+		}
+		query["start"] = *result.Next.Start
+	}
+
+	assignmentList := make([]map[string]interface{}, 0, len(assignments))
+	for _, assignment := range assignments {
+		a := map[string]interface{}{
+			"id":               assignment.ID,
+			"status":           assignment.Status,
+			"resources":        flattenIAMPolicyAssignmentResources(assignment.Resources),
+			"created_at":       assignment.CreatedAt,
+			"last_modified_at": assignment.LastModifiedAt,
+		}
+		if assignment.Target != nil {
+			a["target_type"] = assignment.Target.Type
+			a["target_id"] = assignment.Target.ID
+		}
+		if assignment.Template != nil {
+			a["template_id"] = assignment.Template.ID
+			a["template_version"] = assignment.Template.Version
+		}
+		assignmentList = append(assignmentList, a)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("account_id", accountID)
+	d.Set("assignments", assignmentList)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMPolicyAssignmentsDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyAssignmentsDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_assignments.assignments", "assignments.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_policy_assignments.assignments", "assignments.0.status", "succeeded"),
+					resource.TestCheckResourceAttrSet("data.ibm_iam_policy_assignments.assignments", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyAssignmentsDataSourceConfig(name string) string {
+	return testAccCheckIBMIAMPolicyAssignmentConfig(name, "ibm_iam_policy_template.template.version") + `
+		data "ibm_iam_policy_assignments" "assignments" {
+			template_id      = ibm_iam_policy_assignment.assignment.template_id
+			template_version = ibm_iam_policy_assignment.assignment.template_version
+		}
+	`
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceIBMIAMAccessGroupPoliciesExclusive makes the listed policies the only
+// policies of an access group, the policies are managed by ibm_iam_access_group_policy.
+func ResourceIBMIAMAccessGroupPoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupPoliciesExclusiveCreate,
+		ReadContext:   resourceIBMIAMAccessGroupPoliciesExclusiveRead,
+		UpdateContext: resourceIBMIAMAccessGroupPoliciesExclusiveUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupPoliciesExclusiveDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of access group",
+			},
+			"policy_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the policies of the access group, all the other policies of the access group are deleted",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMAccessGroupPoliciesExclusiveCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	grpID := d.Get("access_group_id").(string)
+	err := reconcileAccessGroupPolicies(context, d, meta, grpID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(grpID)
+	return resourceIBMIAMAccessGroupPoliciesExclusiveRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesExclusiveRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	grpID := d.Id()
+	policyIDs, err := listAccessGroupPolicyIDs(context, meta, grpID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The IDs are kept in the form they are configured, either the policy ID or
+	// the <access_group_id>/<policy_id> ID of ibm_iam_access_group_policy
+	configured := map[string]string{}
+	for _, id := range flex.ExpandStringList(d.Get("policy_ids").(*schema.Set).List()) {
+		configured[accessGroupPolicyID(id)] = id
+	}
+	ids := make([]string, 0, len(policyIDs))
+	for _, id := range policyIDs {
+		if c, ok := configured[id]; ok {
+			ids = append(ids, c)
+		} else {
+			ids = append(ids, fmt.Sprintf("%s/%s", grpID, id))
+		}
+	}
+
+	d.Set("access_group_id", grpID)
+	d.Set("policy_ids", ids)
+	return nil
+}
+
+func resourceIBMIAMAccessGroupPoliciesExclusiveUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := reconcileAccessGroupPolicies(context, d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMIAMAccessGroupPoliciesExclusiveRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesExclusiveDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The policies are owned by their ibm_iam_access_group_policy resources,
+	// removing the exclusive resource stops the reconciliation only
+	log.Printf("[INFO] Policies of access group (%s) are no longer managed exclusively", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// reconcileAccessGroupPolicies deletes the policies of the access group grpID that are not in policy_ids
+func reconcileAccessGroupPolicies(context context.Context, d *schema.ResourceData, meta interface{}, grpID string) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	desired := map[string]bool{}
+	for _, id := range flex.ExpandStringList(d.Get("policy_ids").(*schema.Set).List()) {
+		desired[accessGroupPolicyID(id)] = true
+	}
+	current, err := listAccessGroupPolicyIDs(context, meta, grpID)
+	if err != nil {
+		return err
+	}
+	for _, id := range current {
+		if desired[id] {
+			continue
+		}
+		log.Printf("[INFO] Deleting policy (%s) that is not managed by Terraform from access group (%s)", id, grpID)
+		deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(id)
+		response, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting policy (%s) of access group (%s): %s\n%s", id, grpID, err, response)
+		}
+	}
+	return nil
+}
+
+// listAccessGroupPolicyIDs lists the IDs of the active access policies of the
+// access group grpID. Policies created by template assignments are managed by
+// the enterprise and are left out.
+func listAccessGroupPolicyIDs(context context.Context, meta interface{}, grpID string) ([]string, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	query := map[string]string{
+		"account_id":      userDetails.UserAccount,
+		"access_group_id": grpID,
+		"type":            "access",
+	}
+	policies, response, err := flex.ListV2Policies(context, iamPolicyManagementClient, query)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing policies of access group (%s): %s\n%s", grpID, err, response)
+	}
+	ids := []string{}
+	for _, policy := range policies {
+		if policy.State != nil && *policy.State != "active" {
+			continue
+		}
+		if policy.Template != nil && policy.Template.AssignmentID != nil {
+			continue
+		}
+		ids = append(ids, *policy.ID)
+	}
+	return ids, nil
+}
+
+// accessGroupPolicyID returns the policy ID of id, which is the policy ID or
+// the <access_group_id>/<policy_id> ID of ibm_iam_access_group_policy
+func accessGroupPolicyID(id string) string {
+	parts := strings.Split(id, "/")
+	return parts[len(parts)-1]
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMAccessGroupPoliciesExclusive_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesExclusiveConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies_exclusive.policies", "policy_ids.#", "1"),
+					// Creates a policy outside of Terraform, the next plan deletes it
+					testAccCreateIBMIAMAccessGroupPolicyOutOfBand("ibm_iam_access_group.accgrp"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesExclusiveConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies_exclusive.policies", "policy_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCreateIBMIAMAccessGroupPolicyOutOfBand(group string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+		userDetails, err := acc.TestAccProvider.Meta().(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		grp, ok := s.RootModule().Resources[group]
+		if !ok {
+			return fmt.Errorf("Not found: %s", group)
+		}
+		createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+			"access",
+			[]iampolicymanagementv1.PolicySubject{
+				{
+					Attributes: []iampolicymanagementv1.SubjectAttribute{
+						{Name: core.StringPtr("access_group_id"), Value: core.StringPtr(grp.Primary.ID)},
+					},
+				},
+			},
+			[]iampolicymanagementv1.PolicyRole{
+				{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Viewer")},
+			},
+			[]iampolicymanagementv1.PolicyResource{
+				{
+					Attributes: []iampolicymanagementv1.ResourceAttribute{
+						{Name: core.StringPtr("accountId"), Value: core.StringPtr(userDetails.UserAccount), Operator: core.StringPtr("stringEquals")},
+						{Name: core.StringPtr("serviceName"), Value: core.StringPtr("kms"), Operator: core.StringPtr("stringEquals")},
+					},
+				},
+			},
+		)
+		_, response, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, response)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesExclusiveConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+		}
+
+		resource "ibm_iam_access_group_policies_exclusive" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			policy_ids      = [ibm_iam_access_group_policy.policy.id]
+		}
+	`, name)
+}
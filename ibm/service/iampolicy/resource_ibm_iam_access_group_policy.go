@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -181,6 +182,12 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": flex.IAMPolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.IAMPolicyRuleOperatorSchema(),
+
+			"pattern": flex.IAMPolicyPatternSchema(),
+
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -240,7 +247,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		createPolicyOptions.Description = &des
 	}
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	accessGroupPolicy, res, err := flex.CreateIAMPolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, res)
 	}
@@ -251,7 +258,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -262,7 +269,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
@@ -292,9 +299,10 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	}
 	accessGroupPolicy := &iampolicymanagementv1.Policy{}
 	res := &core.DetailedResponse{}
+	var v2Policy *flex.V2Policy
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || accessGroupPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -305,7 +313,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
@@ -344,6 +352,9 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if v2Policy != nil {
+		flex.SetV2PolicyRule(d, v2Policy)
+	}
 	if accessGroupPolicy.Description != nil {
 		d.Set("description", *accessGroupPolicy.Description)
 	}
@@ -357,7 +368,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -409,7 +420,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			updatePolicyOptions.Description = &des
 		}
 
-		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		res, err := flex.UpdateIAMPolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating access group policy: %s\n%s", err, res)
 		}
@@ -464,7 +475,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 		accessGroupPolicyId,
 	)
 
-	accessGroupPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, _, resp, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -497,10 +508,13 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 		accgrpPolicyID,
 	)
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	// Imported policies are read with the v2 API to include their rule conditions
+	v2Policy, res, err := flex.GetV2Policy(context.Background(), iamPolicyManagementClient, *getPolicyOptions.PolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving access group policy: %s\n%s", err, res)
 	}
+	accessGroupPolicy := flex.ConvertV2PolicyToV1(v2Policy)
+	flex.SetV2PolicyRule(d, v2Policy)
 
 	resources := flex.FlattenPolicyResource(accessGroupPolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(accessGroupPolicy.Resources)
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	iamPolicyAssignmentAPIVersion = "1.0"
+
+	iamPolicyAssignmentAccepted   = "accepted"
+	iamPolicyAssignmentInProgress = "in_progress"
+	iamPolicyAssignmentSucceeded  = "succeeded"
+	iamPolicyAssignmentFailed     = "failed"
+)
+
+type iamPolicyAssignment struct {
+	ID               *string                       `json:"id,omitempty"`
+	AccountID        *string                       `json:"account_id,omitempty"`
+	Target           *iamPolicyAssignmentTarget    `json:"target,omitempty"`
+	Template         *iamPolicyAssignmentTemplate  `json:"template,omitempty"`
+	Templates        []iamPolicyAssignmentTemplate `json:"templates,omitempty"`
+	Resources        []iamPolicyAssignmentResource `json:"resources,omitempty"`
+	Status           *string                       `json:"status,omitempty"`
+	Href             *string                       `json:"href,omitempty"`
+	CreatedAt        *string                       `json:"created_at,omitempty"`
+	CreatedByID      *string                       `json:"created_by_id,omitempty"`
+	LastModifiedAt   *string                       `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string                       `json:"last_modified_by_id,omitempty"`
+}
+
+type iamPolicyAssignmentTarget struct {
+	Type *string `json:"type"`
+	ID   *string `json:"id"`
+}
+
+type iamPolicyAssignmentTemplate struct {
+	ID      *string `json:"id"`
+	Version *string `json:"version"`
+}
+
+// iamPolicyAssignmentResource is the policy created in one account of the target
+type iamPolicyAssignmentResource struct {
+	Target *iamPolicyAssignmentTarget `json:"target,omitempty"`
+	Policy *struct {
+		ResourceCreated *struct {
+			ID *string `json:"id,omitempty"`
+		} `json:"resource_created,omitempty"`
+		Status       *string `json:"status,omitempty"`
+		ErrorMessage *struct {
+			Message *string `json:"message,omitempty"`
+		} `json:"error_message,omitempty"`
+	} `json:"policy,omitempty"`
+}
+
+type iamPolicyAssignmentCollection struct {
+	Assignments []iamPolicyAssignment `json:"assignments"`
+	Next        *struct {
+		Start *string `json:"start,omitempty"`
+	} `json:"next,omitempty"`
+}
+
+func ResourceIBMIAMPolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyAssignmentCreate,
+		ReadContext:   resourceIBMIAMPolicyAssignmentRead,
+		UpdateContext: resourceIBMIAMPolicyAssignmentUpdate,
+		DeleteContext: resourceIBMIAMPolicyAssignmentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"Account", "AccountGroup"}),
+				Description:  "The type of the target, Account for an enterprise account or AccountGroup for an enterprise account group",
+			},
+			"target_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise account or account group the template is assigned to",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the policy template",
+			},
+			"template_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The committed version of the policy template, updating it updates the policies of the target accounts",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise account that owns the assignment",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the assignment",
+			},
+			"resources": iamPolicyAssignmentResourcesSchema(),
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href of the assignment",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the assignment was created",
+			},
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the assignment was last modified",
+			},
+		},
+	}
+}
+
+func iamPolicyAssignmentResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The policies created in the accounts of the target",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"target_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the account the policy is created in",
+				},
+				"policy_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the policy created in the account",
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The status of the assignment in the account",
+				},
+				"error_message": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The reason the assignment failed in the account",
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMIAMPolicyAssignmentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignment := iamPolicyAssignment{
+		Target: &iamPolicyAssignmentTarget{
+			Type: core.StringPtr(d.Get("target_type").(string)),
+			ID:   core.StringPtr(d.Get("target_id").(string)),
+		},
+		Templates: []iamPolicyAssignmentTemplate{
+			{
+				ID:      core.StringPtr(d.Get("template_id").(string)),
+				Version: core.StringPtr(d.Get("template_version").(string)),
+			},
+		},
+	}
+	result := &iamPolicyAssignmentCollection{}
+	response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_assignments", nil,
+		map[string]string{"version": iamPolicyAssignmentAPIVersion}, "", assignment, result)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error assigning policy template (%s): %s\n%s", d.Get("template_id").(string), err, response)
+	}
+	if len(result.Assignments) == 0 || result.Assignments[0].ID == nil {
+		return diag.Errorf("[ERROR] Error assigning policy template (%s): no assignment in response", d.Get("template_id").(string))
+	}
+
+	d.SetId(*result.Assignments[0].ID)
+
+	_, err = waitForIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMPolicyAssignmentRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyAssignmentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	assignment, response, err := getIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing policy assignment (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving policy assignment (%s): %s\n%s", d.Id(), err, response)
+	}
+
+	if assignment.Target != nil {
+		d.Set("target_type", assignment.Target.Type)
+		d.Set("target_id", assignment.Target.ID)
+	}
+	if assignment.Template != nil {
+		d.Set("template_id", assignment.Template.ID)
+		d.Set("template_version", assignment.Template.Version)
+	}
+	d.Set("account_id", assignment.AccountID)
+	d.Set("status", assignment.Status)
+	d.Set("resources", flattenIAMPolicyAssignmentResources(assignment.Resources))
+	d.Set("href", assignment.Href)
+	d.Set("created_at", assignment.CreatedAt)
+	d.Set("last_modified_at", assignment.LastModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMPolicyAssignmentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("template_version") {
+		_, response, err := getIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id())
+		if err != nil {
+			return diag.Errorf("[ERROR] Error retrieving policy assignment (%s): %s\n%s", d.Id(), err, response)
+		}
+		body := map[string]interface{}{
+			"template_version": d.Get("template_version").(string),
+		}
+		response, err = iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.PATCH, "/v1/policy_assignments/{assignment_id}",
+			map[string]string{"assignment_id": d.Id()}, map[string]string{"version": iamPolicyAssignmentAPIVersion}, response.Headers.Get("ETag"), body, nil)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error updating policy assignment (%s): %s\n%s", d.Id(), err, response)
+		}
+		_, err = waitForIAMPolicyAssignment(context, iamPolicyManagementClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMPolicyAssignmentRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyAssignmentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the assignment deletes the policies created in the target accounts
+	response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.DELETE, "/v1/policy_assignments/{assignment_id}",
+		map[string]string{"assignment_id": d.Id()}, map[string]string{"version": iamPolicyAssignmentAPIVersion}, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting policy assignment (%s): %s\n%s", d.Id(), err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getIAMPolicyAssignment(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, assignmentID string) (*iamPolicyAssignment, *core.DetailedResponse, error) {
+	result := &iamPolicyAssignment{}
+	response, err := iamPolicyTemplateRequest(context, client, core.GET, "/v1/policy_assignments/{assignment_id}",
+		map[string]string{"assignment_id": assignmentID}, map[string]string{"version": iamPolicyAssignmentAPIVersion}, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// waitForIAMPolicyAssignment waits until the policies of the assignment are
+// created in all the accounts of the target.
+func waitForIAMPolicyAssignment(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, assignmentID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{iamPolicyAssignmentAccepted, iamPolicyAssignmentInProgress},
+		Target:  []string{iamPolicyAssignmentSucceeded},
+		Refresh: func() (interface{}, string, error) {
+			assignment, response, err := getIAMPolicyAssignment(context, client, assignmentID)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error retrieving policy assignment (%s): %s\n%s", assignmentID, err, response)
+			}
+			if assignment.Status == nil {
+				return assignment, iamPolicyAssignmentInProgress, nil
+			}
+			if *assignment.Status == iamPolicyAssignmentFailed {
+				return assignment, *assignment.Status, fmt.Errorf("[ERROR] Policy assignment (%s) failed: %s", assignmentID, iamPolicyAssignmentErrors(assignment.Resources))
+			}
+			return assignment, *assignment.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func flattenIAMPolicyAssignmentResources(resources []iamPolicyAssignmentResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, r := range resources {
+		resource := map[string]interface{}{}
+		if r.Target != nil && r.Target.ID != nil {
+			resource["target_id"] = *r.Target.ID
+		}
+		if r.Policy != nil {
+			if r.Policy.ResourceCreated != nil && r.Policy.ResourceCreated.ID != nil {
+				resource["policy_id"] = *r.Policy.ResourceCreated.ID
+			}
+			if r.Policy.Status != nil {
+				resource["status"] = *r.Policy.Status
+			}
+			if r.Policy.ErrorMessage != nil && r.Policy.ErrorMessage.Message != nil {
+				resource["error_message"] = *r.Policy.ErrorMessage.Message
+			}
+		}
+		result = append(result, resource)
+	}
+	return result
+}
+
+// iamPolicyAssignmentErrors returns the errors of the accounts the assignment failed in
+func iamPolicyAssignmentErrors(resources []iamPolicyAssignmentResource) string {
+	errors := ""
+	for _, r := range flattenIAMPolicyAssignmentResources(resources) {
+		if message, ok := r["error_message"]; ok {
+			errors += fmt.Sprintf("\n%v: %v", r["target_id"], message)
+		}
+	}
+	return errors
+}
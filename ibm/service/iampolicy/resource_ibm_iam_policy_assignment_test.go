@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMPolicyAssignment_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheckEnterprise(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyAssignmentConfig(name, "ibm_iam_policy_template.template.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "target_type", "AccountGroup"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "template_version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "status", "succeeded"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyAssignmentConfig(name, "ibm_iam_policy_template_version.version.version"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "template_version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_assignment.assignment", "status", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyAssignmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_policy_assignment" {
+			continue
+		}
+		statusCode, err := testAccIBMIAMPolicyTemplateStatus(fmt.Sprintf("/v1/policy_assignments/%s", rs.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("Policy assignment still exists: %s", rs.Primary.ID)
+		}
+		if statusCode != 404 {
+			return fmt.Errorf("[ERROR] Error waiting for policy assignment (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMIAMPolicyAssignmentConfig(name, templateVersion string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+
+		resource "ibm_enterprise_account_group" "account_group" {
+			parent                 = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name                   = "%[1]s"
+			primary_contact_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+		}
+
+		resource "ibm_iam_policy_template" "template" {
+			name      = "%[1]s"
+			committed = true
+			policy {
+				roles = ["Viewer"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+			}
+		}
+
+		resource "ibm_iam_policy_template_version" "version" {
+			template_id = ibm_iam_policy_template.template.template_id
+			committed   = true
+			policy {
+				roles = ["Viewer", "Editor"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+			}
+		}
+
+		resource "ibm_iam_policy_assignment" "assignment" {
+			target_type      = "AccountGroup"
+			target_id        = ibm_enterprise_account_group.account_group.id
+			template_id      = ibm_iam_policy_template.template.template_id
+			template_version = %[2]s
+		}
+	`, name, templateVersion)
+}
@@ -0,0 +1,539 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// IAM policy templates are not implemented by the iampolicymanagementv1 SDK,
+// the requests are sent through the client of the policy management API.
+
+type iamPolicyTemplate struct {
+	ID               *string                `json:"id,omitempty"`
+	Name             *string                `json:"name,omitempty"`
+	Description      *string                `json:"description,omitempty"`
+	AccountID        *string                `json:"account_id,omitempty"`
+	Version          *string                `json:"version,omitempty"`
+	Committed        *bool                  `json:"committed,omitempty"`
+	Policy           *iamPolicyTemplateRule `json:"policy,omitempty"`
+	State            *string                `json:"state,omitempty"`
+	Href             *string                `json:"href,omitempty"`
+	CreatedAt        *string                `json:"created_at,omitempty"`
+	CreatedByID      *string                `json:"created_by_id,omitempty"`
+	LastModifiedAt   *string                `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string                `json:"last_modified_by_id,omitempty"`
+}
+
+// iamPolicyTemplateRule is the policy of a template, a v2 policy without subject
+type iamPolicyTemplateRule struct {
+	Type        *string                `json:"type"`
+	Description *string                `json:"description,omitempty"`
+	Resource    *flex.V2PolicyResource `json:"resource"`
+	Pattern     *string                `json:"pattern,omitempty"`
+	Rule        *flex.V2PolicyRule     `json:"rule,omitempty"`
+	Control     *flex.V2PolicyControl  `json:"control"`
+}
+
+func ResourceIBMIAMPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyTemplateCreate,
+		ReadContext:   resourceIBMIAMPolicyTemplateRead,
+		UpdateContext: resourceIBMIAMPolicyTemplateUpdate,
+		DeleteContext: resourceIBMIAMPolicyTemplateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the policy template, unique in the account",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the policy template",
+			},
+			"policy": iamPolicyTemplatePolicySchema(),
+			"committed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Commits the template version, a committed version can't be changed and can be assigned",
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the policy template",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the policy template created with the template",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account of the policy template",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the policy template",
+			},
+		},
+	}
+}
+
+func iamPolicyTemplatePolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MaxItems:    1,
+		Description: "The policy that is created in the accounts the template is assigned to",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "access",
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"access", "authorization"}),
+					Description:  "The type of the policy, access or authorization",
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The description of the policy",
+				},
+				"roles": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Role names of the policy definition",
+				},
+				"resource_attributes": {
+					Type:        schema.TypeList,
+					Required:    true,
+					Description: "The resource attributes of the policy",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Name of the attribute",
+							},
+							"value": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Value of the attribute",
+							},
+							"operator": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "stringEquals",
+								Description: "Operator of the attribute",
+							},
+						},
+					},
+				},
+				"resource_tags": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The access management tags of the policy",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Name of the tag",
+							},
+							"value": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: "Value of the tag",
+							},
+							"operator": {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "stringEquals",
+								Description: "Operator of the tag",
+							},
+						},
+					},
+				},
+				"rule_conditions": flex.IAMPolicyRuleConditionsSchema(),
+				"rule_operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"and", "or"}),
+					Description:  "Operator that combines the rule conditions",
+				},
+				"pattern": flex.IAMPolicyPatternSchema(),
+			},
+		},
+	}
+}
+
+func resourceIBMIAMPolicyTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy, err := expandIAMPolicyTemplatePolicy(meta, d.Get("policy").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	template := iamPolicyTemplate{
+		Name:      core.StringPtr(d.Get("name").(string)),
+		AccountID: core.StringPtr(userDetails.UserAccount),
+		Policy:    policy,
+		Committed: core.BoolPtr(d.Get("committed").(bool)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		template.Description = core.StringPtr(description.(string))
+	}
+
+	result := &iamPolicyTemplate{}
+	response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_templates", nil, nil, "", template, result)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error creating policy template: %s\n%s", err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *result.ID, *result.Version))
+
+	return resourceIBMIAMPolicyTemplateRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, version, err := parseIAMPolicyTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, response, err := getIAMPolicyTemplateVersion(context, iamPolicyManagementClient, templateID, version)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing policy template (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving policy template (%s): %s\n%s", d.Id(), err, response)
+	}
+
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("template_id", templateID)
+	d.Set("version", version)
+	d.Set("account_id", template.AccountID)
+	d.Set("state", template.State)
+	if template.Committed != nil {
+		d.Set("committed", *template.Committed)
+	}
+	policy, err := flattenIAMPolicyTemplatePolicy(meta, template.Policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceIBMIAMPolicyTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templateID, version, err := parseIAMPolicyTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateIAMPolicyTemplateVersion(context, d, meta, templateID, version)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMIAMPolicyTemplateRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, _, err := parseIAMPolicyTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Deleting the template deletes all of its versions
+	response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.DELETE, "/v1/policy_templates/{policy_template_id}",
+		map[string]string{"policy_template_id": templateID}, nil, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting policy template (%s): %s\n%s", templateID, err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// updateIAMPolicyTemplateVersion replaces the policy and the description of a
+// template version and commits it. Committed versions can't be changed.
+func updateIAMPolicyTemplateVersion(context context.Context, d *schema.ResourceData, meta interface{}, templateID, version string) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	oldCommitted, _ := d.GetChange("committed")
+	if oldCommitted.(bool) && (d.HasChange("policy") || d.HasChange("description") || d.HasChange("committed")) {
+		return fmt.Errorf("[ERROR] Version %s of policy template %s is committed and can't be changed, create a new template version instead", version, templateID)
+	}
+	pathParams := map[string]string{
+		"policy_template_id": templateID,
+		"version":            version,
+	}
+
+	if d.HasChange("policy") || d.HasChange("description") {
+		current, response, err := getIAMPolicyTemplateVersion(context, iamPolicyManagementClient, templateID, version)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving policy template (%s/%s): %s\n%s", templateID, version, err, response)
+		}
+		policy, err := expandIAMPolicyTemplatePolicy(meta, d.Get("policy").([]interface{}))
+		if err != nil {
+			return err
+		}
+		template := iamPolicyTemplate{
+			Policy:      policy,
+			Description: core.StringPtr(d.Get("description").(string)),
+		}
+		if current.Name != nil {
+			template.Name = current.Name
+		}
+		response, err = iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.PUT, "/v1/policy_templates/{policy_template_id}/versions/{version}",
+			pathParams, nil, response.Headers.Get("ETag"), template, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating policy template (%s/%s): %s\n%s", templateID, version, err, response)
+		}
+	}
+
+	if d.HasChange("committed") && d.Get("committed").(bool) {
+		_, response, err := getIAMPolicyTemplateVersion(context, iamPolicyManagementClient, templateID, version)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving policy template (%s/%s): %s\n%s", templateID, version, err, response)
+		}
+		response, err = iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_templates/{policy_template_id}/versions/{version}/commit",
+			pathParams, nil, response.Headers.Get("ETag"), nil, nil)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error committing policy template (%s/%s): %s\n%s", templateID, version, err, response)
+		}
+	}
+	return nil
+}
+
+func getIAMPolicyTemplateVersion(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, templateID, version string) (*iamPolicyTemplate, *core.DetailedResponse, error) {
+	result := &iamPolicyTemplate{}
+	response, err := iamPolicyTemplateRequest(context, client, core.GET, "/v1/policy_templates/{policy_template_id}/versions/{version}",
+		map[string]string{"policy_template_id": templateID, "version": version}, nil, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// expandIAMPolicyTemplatePolicy builds the policy of a template from the policy
+// block, the role names are resolved with the roles of the serviceName attribute.
+func expandIAMPolicyTemplatePolicy(meta interface{}, policies []interface{}) (*iamPolicyTemplateRule, error) {
+	p := policies[0].(map[string]interface{})
+	policy := &iamPolicyTemplateRule{
+		Type:     core.StringPtr(p["type"].(string)),
+		Resource: &flex.V2PolicyResource{Attributes: []flex.V2PolicyAttribute{}},
+		Control:  &flex.V2PolicyControl{Grant: flex.V2PolicyGrant{Roles: []flex.V2PolicyRole{}}},
+	}
+	if description, ok := p["description"].(string); ok && description != "" {
+		policy.Description = core.StringPtr(description)
+	}
+	if pattern, ok := p["pattern"].(string); ok && pattern != "" {
+		policy.Pattern = core.StringPtr(pattern)
+	}
+	policy.Rule = flex.ExpandV2PolicyRuleConditions(p["rule_conditions"].([]interface{}), p["rule_operator"].(string))
+
+	serviceName := ""
+	for _, a := range p["resource_attributes"].([]interface{}) {
+		attribute := a.(map[string]interface{})
+		if attribute["name"].(string) == "serviceName" {
+			serviceName = attribute["value"].(string)
+		}
+		policy.Resource.Attributes = append(policy.Resource.Attributes, flex.V2PolicyAttribute{
+			Key:      core.StringPtr(attribute["name"].(string)),
+			Operator: core.StringPtr(attribute["operator"].(string)),
+			Value:    attribute["value"].(string),
+		})
+	}
+	for _, t := range p["resource_tags"].([]interface{}) {
+		tag := t.(map[string]interface{})
+		policy.Resource.Tags = append(policy.Resource.Tags, flex.V2PolicyAttribute{
+			Key:      core.StringPtr(tag["name"].(string)),
+			Operator: core.StringPtr(tag["operator"].(string)),
+			Value:    tag["value"].(string),
+		})
+	}
+
+	roles, err := listIAMPolicyTemplateRoles(meta, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	policyRoles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(p["roles"].([]interface{})), roles)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range policyRoles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, flex.V2PolicyRole{RoleID: r.RoleID})
+	}
+	return policy, nil
+}
+
+func flattenIAMPolicyTemplatePolicy(meta interface{}, policy *iamPolicyTemplateRule) ([]map[string]interface{}, error) {
+	if policy == nil {
+		return []map[string]interface{}{}, nil
+	}
+	p := map[string]interface{}{
+		"type":            policy.Type,
+		"rule_conditions": flex.FlattenV2PolicyRuleConditions(policy.Rule),
+	}
+	if policy.Description != nil {
+		p["description"] = *policy.Description
+	}
+	if policy.Pattern != nil {
+		p["pattern"] = *policy.Pattern
+	}
+	if policy.Rule != nil && len(policy.Rule.Conditions) > 0 && policy.Rule.Operator != nil {
+		p["rule_operator"] = *policy.Rule.Operator
+	}
+
+	serviceName := ""
+	attributes := []map[string]interface{}{}
+	tags := []map[string]interface{}{}
+	if policy.Resource != nil {
+		for _, a := range policy.Resource.Attributes {
+			value := fmt.Sprintf("%v", a.Value)
+			if *a.Key == "serviceName" {
+				serviceName = value
+			}
+			attributes = append(attributes, map[string]interface{}{
+				"name":     *a.Key,
+				"value":    value,
+				"operator": *a.Operator,
+			})
+		}
+		for _, t := range policy.Resource.Tags {
+			tags = append(tags, map[string]interface{}{
+				"name":     *t.Key,
+				"value":    fmt.Sprintf("%v", t.Value),
+				"operator": *t.Operator,
+			})
+		}
+	}
+	p["resource_attributes"] = attributes
+	p["resource_tags"] = tags
+
+	roleNames := []string{}
+	if policy.Control != nil && len(policy.Control.Grant.Roles) > 0 {
+		roles, err := listIAMPolicyTemplateRoles(meta, serviceName)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range policy.Control.Grant.Roles {
+			roleNames = append(roleNames, iamPolicyTemplateRoleName(*r.RoleID, roles))
+		}
+	}
+	p["roles"] = roleNames
+
+	return []map[string]interface{}{p}, nil
+}
+
+// listIAMPolicyTemplateRoles lists the roles that can be granted on serviceName
+func listIAMPolicyTemplateRoles(meta interface{}, serviceName string) ([]iampolicymanagementv1.PolicyRole, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	if serviceName == "" {
+		serviceName = "alliamserviceroles"
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   core.StringPtr(userDetails.UserAccount),
+		ServiceName: core.StringPtr(serviceName),
+	}
+	roleList, response, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles of %s: %s\n%s", serviceName, err, response)
+	}
+	return flex.MapRoleListToPolicyRoles(*roleList), nil
+}
+
+func iamPolicyTemplateRoleName(roleID string, roles []iampolicymanagementv1.PolicyRole) string {
+	for _, r := range roles {
+		if r.RoleID != nil && *r.RoleID == roleID && r.DisplayName != nil {
+			return *r.DisplayName
+		}
+	}
+	parts := strings.Split(roleID, ":")
+	return parts[len(parts)-1]
+}
+
+// parseIAMPolicyTemplateID splits an ID of the form <template_id>/<version>
+func parseIAMPolicyTemplateID(id string) (templateID, version string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of templateID/version", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func iamPolicyTemplateRequest(context context.Context, client *iampolicymanagementv1.IamPolicyManagementV1, method, path string, pathParams map[string]string, query map[string]string, etag string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = client.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if etag != "" {
+		builder.AddHeader("If-Match", etag)
+	}
+	for k, v := range query {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMPolicyTemplate_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Viewer", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "version", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "committed", "false"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "policy.0.roles.0", "Viewer"),
+					resource.TestCheckResourceAttrSet("ibm_iam_policy_template.template", "template_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Editor", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "committed", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template.template", "policy.0.roles.0", "Editor"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_policy_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyTemplateDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_policy_template" && rs.Type != "ibm_iam_policy_template_version" {
+			continue
+		}
+		parts := strings.Split(rs.Primary.ID, "/")
+		statusCode, err := testAccIBMIAMPolicyTemplateStatus(fmt.Sprintf("/v1/policy_templates/%s/versions/%s", parts[0], parts[1]))
+		if err == nil {
+			return fmt.Errorf("Policy template still exists: %s", rs.Primary.ID)
+		}
+		if statusCode != 404 {
+			return fmt.Errorf("[ERROR] Error waiting for policy template (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+// testAccIBMIAMPolicyTemplateStatus returns the status code of a GET request
+// on path of the policy management API
+func testAccIBMIAMPolicyTemplateStatus(path string) (int, error) {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return 0, err
+	}
+	builder := core.NewRequestBuilder(core.GET)
+	if _, err = builder.ResolveRequestURL(iamPolicyManagementClient.Service.Options.URL, path, nil); err != nil {
+		return 0, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddQuery("version", "1.0")
+	request, err := builder.Build()
+	if err != nil {
+		return 0, err
+	}
+	response, err := iamPolicyManagementClient.Service.Request(request, nil)
+	if response == nil {
+		return 0, err
+	}
+	return response.StatusCode, err
+}
+
+func testAccCheckIBMIAMPolicyTemplateConfig(name, role string, committed bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_policy_template" "template" {
+			name        = "%s"
+			description = "IAM policy template for test scenario"
+			committed   = %t
+			policy {
+				type  = "access"
+				roles = ["%s"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+			}
+		}
+	`, name, committed, role)
+}
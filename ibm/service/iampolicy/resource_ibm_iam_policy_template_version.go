@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMIAMPolicyTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyTemplateVersionCreate,
+		ReadContext:   resourceIBMIAMPolicyTemplateVersionRead,
+		UpdateContext: resourceIBMIAMPolicyTemplateVersionUpdate,
+		DeleteContext: resourceIBMIAMPolicyTemplateVersionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"template_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the policy template",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the policy template version",
+			},
+			"policy": iamPolicyTemplatePolicySchema(),
+			"committed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Commits the template version, a committed version can't be changed and can be assigned",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the policy template",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the policy template",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account of the policy template",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the policy template version",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMPolicyTemplateVersionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID := d.Get("template_id").(string)
+
+	policy, err := expandIAMPolicyTemplatePolicy(meta, d.Get("policy").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	template := iamPolicyTemplate{
+		Policy:    policy,
+		Committed: core.BoolPtr(d.Get("committed").(bool)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		template.Description = core.StringPtr(description.(string))
+	}
+
+	result := &iamPolicyTemplate{}
+	response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.POST, "/v1/policy_templates/{policy_template_id}/versions",
+		map[string]string{"policy_template_id": templateID}, nil, "", template, result)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error creating version of policy template (%s): %s\n%s", templateID, err, response)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", templateID, *result.Version))
+
+	return resourceIBMIAMPolicyTemplateVersionRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateVersionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, version, err := parseIAMPolicyTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	template, response, err := getIAMPolicyTemplateVersion(context, iamPolicyManagementClient, templateID, version)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing policy template version (%s) from state because it is not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving policy template version (%s): %s\n%s", d.Id(), err, response)
+	}
+
+	d.Set("template_id", templateID)
+	d.Set("version", version)
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("account_id", template.AccountID)
+	d.Set("state", template.State)
+	if template.Committed != nil {
+		d.Set("committed", *template.Committed)
+	}
+	policy, err := flattenIAMPolicyTemplatePolicy(meta, template.Policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("policy", policy)
+
+	return nil
+}
+
+func resourceIBMIAMPolicyTemplateVersionUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	templateID, version, err := parseIAMPolicyTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = updateIAMPolicyTemplateVersion(context, d, meta, templateID, version)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMIAMPolicyTemplateVersionRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateVersionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	templateID, version, err := parseIAMPolicyTemplateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := iamPolicyTemplateRequest(context, iamPolicyManagementClient, core.DELETE, "/v1/policy_templates/{policy_template_id}/versions/{version}",
+		map[string]string{"policy_template_id": templateID, "version": version}, nil, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("[ERROR] Error deleting policy template version (%s): %s\n%s", d.Id(), err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMPolicyTemplateVersion_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateVersionConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_policy_template_version.version", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template_version.version", "version", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template_version.version", "committed", "true"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template_version.version", "policy.0.rule_conditions.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_policy_template_version.version", "policy.0.pattern", "time-based-conditions:weekly:custom-hours"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_policy_template_version.version",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyTemplateVersionConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_policy_template" "template" {
+			name      = "%s"
+			committed = true
+			policy {
+				roles = ["Viewer"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+			}
+		}
+
+		resource "ibm_iam_policy_template_version" "version" {
+			template_id = ibm_iam_policy_template.template.template_id
+			description = "Grants access during working hours"
+			committed   = true
+			policy {
+				roles = ["Viewer"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+				rule_conditions {
+					key      = "{{environment.attributes.current_time}}"
+					operator = "timeGreaterThanOrEquals"
+					value    = ["09:00:00+00:00"]
+				}
+				rule_conditions {
+					key      = "{{environment.attributes.current_time}}"
+					operator = "timeLessThanOrEquals"
+					value    = ["17:00:00+00:00"]
+				}
+				rule_operator = "and"
+				pattern       = "time-based-conditions:weekly:custom-hours"
+			}
+		}
+	`, name)
+}
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -189,6 +190,12 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"rule_conditions": flex.IAMPolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.IAMPolicyRuleOperatorSchema(),
+
+			"pattern": flex.IAMPolicyPatternSchema(),
 		},
 	}
 }
@@ -263,7 +270,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		createPolicyOptions.Description = &des
 	}
 
-	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	servicePolicy, res, err := flex.CreateIAMPolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
 	}
@@ -274,7 +281,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -286,7 +293,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -324,12 +331,13 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	servicePolicyID := parts[1]
 	servicePolicy := &iampolicymanagementv1.Policy{}
 	res := &core.DetailedResponse{}
+	var v2Policy *flex.V2Policy
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
 	)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || servicePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -341,7 +349,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || servicePolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
@@ -377,6 +385,9 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 			d.Set("account_management", true)
 		}
 	}
+	if v2Policy != nil {
+		flex.SetV2PolicyRule(d, v2Policy)
+	}
 	if servicePolicy.Description != nil {
 		d.Set("description", *servicePolicy.Description)
 	}
@@ -386,7 +397,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -452,7 +463,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 			servicePolicyID,
 		)
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -475,7 +486,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			updatePolicyOptions.Description = &des
 		}
 
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, err = flex.UpdateIAMPolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
 		}
@@ -531,7 +542,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 		servicePolicyID,
 	)
 
-	servicePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, _, resp, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || servicePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -562,10 +573,13 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		servicePolicyID,
 	)
-	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	// Imported policies are read with the v2 API to include their rule conditions
+	v2Policy, _, err := flex.GetV2Policy(context.Background(), iamPolicyManagementClient, *getPolicyOptions.PolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
 	}
+	servicePolicy := flex.ConvertV2PolicyToV1(v2Policy)
+	flex.SetV2PolicyRule(d, v2Policy)
 	resources := flex.FlattenPolicyResource(servicePolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(servicePolicy.Resources)
 	d.Set("resource_tags", flex.FlattenPolicyResourceTags(servicePolicy.Resources))
@@ -302,6 +302,41 @@ func TestAccIBMIAMServicePolicy_With_Resource_Tags(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServicePolicy_With_Time_Based_Conditions(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServicePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMServicePolicyWithoutConditions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "rule_conditions.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMServicePolicyTimeBasedConditions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "pattern", "time-based-conditions:weekly:custom-hours"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "rule_operator", "and"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "rule_conditions.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_service_policy.policy", "rule_conditions.0.value.#", "5"),
+				),
+			},
+			{
+				ResourceName:            "ibm_iam_service_policy.policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resources", "resource_attributes"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMServicePolicyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -680,3 +715,55 @@ func testAccCheckIBMIAMServicePolicyUpdateResourceTags(name string) string {
 
 	`, name)
 }
+
+func testAccCheckIBMIAMServicePolicyWithoutConditions(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_service_policy" "policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMServicePolicyTimeBasedConditions(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_service_policy" "policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.day_of_week}}"
+				operator = "dayOfWeekAnyOf"
+				value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["17:00:00+00:00"]
+			}
+			rule_operator = "and"
+			pattern       = "time-based-conditions:weekly:custom-hours"
+			description   = "IAM policy with time-based conditions"
+		}
+	`, name)
+}
@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -189,6 +190,12 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"rule_conditions": flex.IAMPolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.IAMPolicyRuleOperatorSchema(),
+
+			"pattern": flex.IAMPolicyPatternSchema(),
 		},
 	}
 }
@@ -263,7 +270,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		createPolicyOptions.Description = &des
 	}
 
-	trustedProfilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	trustedProfilePolicy, res, err := flex.CreateIAMPolicy(iamPolicyManagementClient, d, createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating trustedProfilePolicy: %s %s", err, res)
 	}
@@ -274,7 +281,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -286,7 +293,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("profile_id"); ok && v != nil {
@@ -324,12 +331,13 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	trustedProfilePolicyID := parts[1]
 	trustedProfilePolicy := &iampolicymanagementv1.Policy{}
 	res := &core.DetailedResponse{}
+	var v2Policy *flex.V2Policy
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		trustedProfilePolicyID,
 	)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || trustedProfilePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -341,7 +349,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		trustedProfilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		trustedProfilePolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || trustedProfilePolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
@@ -377,6 +385,9 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 			d.Set("account_management", true)
 		}
 	}
+	if v2Policy != nil {
+		flex.SetV2PolicyRule(d, v2Policy)
+	}
 	if trustedProfilePolicy.Description != nil {
 		d.Set("description", *trustedProfilePolicy.Description)
 	}
@@ -386,7 +397,7 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 
 func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -452,7 +463,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 			trustedProfilePolicyID,
 		)
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -475,7 +486,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			updatePolicyOptions.Description = &des
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		resp, err := flex.UpdateIAMPolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s: %s", err, resp)
 		}
@@ -531,7 +542,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 		trustedProfilePolicyID,
 	)
 
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	trustedProfilePolicy, _, resp, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || trustedProfilePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -562,10 +573,13 @@ func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (inter
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		trustedProfilePolicyID,
 	)
-	trustedProfilePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	// Imported policies are read with the v2 API to include their rule conditions
+	v2Policy, resp, err := flex.GetV2Policy(context.Background(), iamPolicyManagementClient, *getPolicyOptions.PolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, resp)
 	}
+	trustedProfilePolicy := flex.ConvertV2PolicyToV1(v2Policy)
+	flex.SetV2PolicyRule(d, v2Policy)
 	resources := flex.FlattenPolicyResource(trustedProfilePolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(trustedProfilePolicy.Resources)
 	d.Set("resource_tags", flex.FlattenPolicyResourceTags(trustedProfilePolicy.Resources))
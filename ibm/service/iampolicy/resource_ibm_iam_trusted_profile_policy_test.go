@@ -300,6 +300,41 @@ func TestAccIBMIAMTrustedProfilePolicy_With_Resource_Tags(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMTrustedProfilePolicy_With_Time_Based_Conditions(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfilePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyWithoutConditions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_conditions.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyTimeBasedConditions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "pattern", "time-based-conditions:weekly:custom-hours"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_operator", "and"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_conditions.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "rule_conditions.0.value.#", "5"),
+				),
+			},
+			{
+				ResourceName:            "ibm_iam_trusted_profile_policy.policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resources", "resource_attributes"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMTrustedProfilePolicyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -673,3 +708,55 @@ func testAccCheckIBMIAMTrustedProfilePolicyUpdateResourceTags(name string) strin
 
 	`, name)
 }
+
+func testAccCheckIBMIAMTrustedProfilePolicyWithoutConditions(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_trusted_profile" "profileID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy" {
+			profile_id = ibm_iam_trusted_profile.profileID.id
+			roles = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyTimeBasedConditions(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_trusted_profile" "profileID" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy" {
+			profile_id = ibm_iam_trusted_profile.profileID.id
+			roles = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.day_of_week}}"
+				operator = "dayOfWeekAnyOf"
+				value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["17:00:00+00:00"]
+			}
+			rule_operator = "and"
+			pattern       = "time-based-conditions:weekly:custom-hours"
+			description   = "IAM policy with time-based conditions"
+		}
+	`, name)
+}
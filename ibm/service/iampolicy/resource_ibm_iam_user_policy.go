@@ -4,6 +4,7 @@
 package iampolicy
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -179,6 +180,12 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"rule_conditions": flex.IAMPolicyRuleConditionsSchema(),
+
+			"rule_operator": flex.IAMPolicyRuleOperatorSchema(),
+
+			"pattern": flex.IAMPolicyPatternSchema(),
 		},
 	}
 }
@@ -242,7 +249,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		createPolicyOptions.Description = &des
 	}
 
-	userPolicy, resp, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	userPolicy, resp, err := flex.CreateIAMPolicy(iamPolicyManagementClient, d, createPolicyOptions)
 
 	if err != nil {
 		return fmt.Errorf("Error creating user policies: %s, %s", err, resp)
@@ -254,7 +261,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -266,7 +273,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, _, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
@@ -300,9 +307,10 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	}
 	userPolicy := &iampolicymanagementv1.Policy{}
 	res := &core.DetailedResponse{}
+	var v2Policy *flex.V2Policy
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 
 		if err != nil || userPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -314,7 +322,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if conns.IsResourceTimeoutError(err) {
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, v2Policy, res, err = flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	}
 	if err != nil || userPolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving userPolicy: %s %s", err, res)
@@ -345,6 +353,9 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 			d.Set("account_management", true)
 		}
 	}
+	if v2Policy != nil {
+		flex.SetV2PolicyRule(d, v2Policy)
+	}
 	if userPolicy.Description != nil {
 		d.Set("description", *userPolicy.Description)
 	}
@@ -356,7 +367,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -403,7 +414,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 		getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
 			PolicyID: &userPolicyID,
 		}
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -426,7 +437,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			updatePolicyOptions.Description = &des
 		}
 
-		_, resp, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		resp, err := flex.UpdateIAMPolicy(iamPolicyManagementClient, d, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating user policy: %s, %s", err, resp)
 		}
@@ -478,7 +489,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 		userPolicyID,
 	)
 
-	userPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, _, resp, err := flex.GetIAMPolicy(iamPolicyManagementClient, d, getPolicyOptions)
 	if err != nil || userPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -510,10 +521,13 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
 		userPolicyID,
 	)
-	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	// Imported policies are read with the v2 API to include their rule conditions
+	v2Policy, _, err := flex.GetV2Policy(context.Background(), iamPolicyManagementClient, *getPolicyOptions.PolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving User Policy: %s", err)
 	}
+	userPolicy := flex.ConvertV2PolicyToV1(v2Policy)
+	flex.SetV2PolicyRule(d, v2Policy)
 	resources := flex.FlattenPolicyResource(userPolicy.Resources)
 	resource_attributes := flex.FlattenPolicyResourceAttributes(userPolicy.Resources)
 	d.Set("resource_tags", flex.FlattenPolicyResourceTags(userPolicy.Resources))
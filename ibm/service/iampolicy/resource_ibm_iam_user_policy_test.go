@@ -313,6 +313,42 @@ func TestAccIBMIAMUserPolicy_With_Resource_Tags(t *testing.T) {
 
 }
 
+func TestAccIBMIAMUserPolicy_With_Time_Based_Conditions(t *testing.T) {
+	var conf iampolicymanagementv1.Policy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMUserPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMUserPolicyWithoutConditions(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMUserPolicyExists("ibm_iam_user_policy.policy", conf),
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "rule_conditions.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMUserPolicyTimeBasedConditions(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "pattern", "time-based-conditions:weekly:custom-hours"),
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "rule_operator", "and"),
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "rule_conditions.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_user_policy.policy", "rule_conditions.0.value.#", "5"),
+				),
+			},
+			{
+				ResourceName:            "ibm_iam_user_policy.policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resources", "resource_attributes"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMUserPolicyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -671,3 +707,47 @@ func testAccCheckIBMIAMUserPolicyResourceTagsUpdate() string {
 	}
 	`, acc.IAMUser)
 }
+
+func testAccCheckIBMIAMUserPolicyWithoutConditions() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_user_policy" "policy" {
+			ibm_id = "%s"
+			roles  = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+	`, acc.IAMUser)
+}
+
+func testAccCheckIBMIAMUserPolicyTimeBasedConditions() string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_user_policy" "policy" {
+			ibm_id = "%s"
+			roles  = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.day_of_week}}"
+				operator = "dayOfWeekAnyOf"
+				value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["17:00:00+00:00"]
+			}
+			rule_operator = "and"
+			pattern       = "time-based-conditions:weekly:custom-hours"
+			description   = "IAM policy with time-based conditions"
+		}
+	`, acc.IAMUser)
+}
@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyRegistrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID or CRN",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the key, the registrations of all the keys of the instance are returned when it is not set",
+			},
+			"resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the registrations by the CRN of the registered resource, wildcards (*) are supported",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The registrations of the keys",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key associated with the registration",
+						},
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource that is registered with the key",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the registration",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the registration prevents the deletion of the key",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version used by the registered resource",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource that created the registration",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was created",
+						},
+						"updated_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource that last updated the registration",
+						},
+						"last_updated": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was last updated",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegistrationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+	resourceCRN := d.Get("resource_crn").(string)
+
+	registrations, err := kpAPI.ListRegistrations(context, keyID, resourceCRN)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error listing key registrations: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, keyID))
+	if err := d.Set("registrations", flattenKMSKeyRegistrations(registrations.Registrations)); err != nil {
+		return diag.Errorf("[ERROR] Error setting registrations: %s", err)
+	}
+
+	return nil
+}
+
+func flattenKMSKeyRegistrations(registrations []kp.Registration) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(registrations))
+	for _, registration := range registrations {
+		r := map[string]interface{}{
+			"key_id":               registration.KeyID,
+			"resource_crn":         registration.ResourceCrn,
+			"description":          registration.Description,
+			"prevent_key_deletion": registration.PreventKeyDeletion,
+			"key_version_id":       registration.KeyVersion.ID,
+			"created_by":           registration.CreatedBy,
+			"updated_by":           registration.UpdatedBy,
+		}
+		if registration.CreationDate != nil {
+			r["creation_date"] = registration.CreationDate.Format(time.RFC3339)
+		}
+		if registration.LastUpdateDate != nil {
+			r["last_updated"] = registration.LastUpdateDate.Format(time.RFC3339)
+		}
+		result = append(result, r)
+	}
+	return result
+}
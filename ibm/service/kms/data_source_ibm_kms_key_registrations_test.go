@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyRegistrationsDataSource_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-kms-reg-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRegistrationsDataSourceConfig(instanceName, keyName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_key_registrations.registrations", "registrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.registrations", "registrations.0.resource_crn", "ibm_cos_bucket.bucket", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyRegistrationsDataSourceConfig(instanceName, keyName, bucketName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%[1]s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_resource_instance" "cos_instance" {
+		name     = "%[1]s-cos"
+		service  = "cloud-object-storage"
+		plan     = "standard"
+		location = "global"
+	}
+
+	resource "ibm_iam_authorization_policy" "policy" {
+		source_service_name         = "cloud-object-storage"
+		source_resource_instance_id = ibm_resource_instance.cos_instance.guid
+		target_service_name         = "kms"
+		target_resource_instance_id = ibm_resource_instance.kp_instance.guid
+		roles                       = ["Reader"]
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%[2]s"
+		standard_key = false
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%[3]s"
+		resource_instance_id = ibm_resource_instance.cos_instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+		key_protect          = ibm_kms_key.test.id
+		depends_on           = [ibm_iam_authorization_policy.policy]
+	}
+
+	data "ibm_kms_key_registrations" "registrations" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+		depends_on  = [ibm_cos_bucket.bucket]
+	}
+`, instanceName, keyName, bucketName)
+}
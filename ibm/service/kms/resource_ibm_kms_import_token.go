@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMKmsImportToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsImportTokenCreate,
+		ReadContext:   resourceIBMKmsImportTokenRead,
+		DeleteContext: resourceIBMKmsImportTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Default:      "public",
+				Description:  "public or private",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      600,
+				ValidateFunc: validation.IntBetween(300, 86400),
+				Description:  "The time in seconds from the creation of the import token that determines how long its associated public key remains valid",
+			},
+			"max_allowed_retrievals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 500),
+				Description:  "The number of times that the public key of the import token can be retrieved",
+			},
+			"key_material": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				// Only the hash of the key material is kept in the state
+				StateFunc: func(v interface{}) string {
+					hash := sha256.Sum256([]byte(v.(string)))
+					return hex.EncodeToString(hash[:])
+				},
+				Description: "The base64 encoded key material to encrypt with the import token, the state only keeps its SHA-256",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token was created",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token expires",
+			},
+			"remaining_retrievals": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of retrievals that are available for the import token",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded public key of the import token",
+			},
+			"nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded nonce of the import token",
+			},
+			"encrypted_payload": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key material encrypted with the public key of the import token",
+			},
+			"encrypted_nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The nonce encrypted with the key material",
+			},
+			"iv_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The initialization vector used to encrypt the nonce",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsImportTokenCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	importToken, err := kpAPI.CreateImportToken(context, d.Get("expiration").(int), d.Get("max_allowed_retrievals").(int))
+	if err != nil {
+		return diag.Errorf("[ERROR] Error creating import token: %s", err)
+	}
+
+	transportKey, err := kpAPI.GetImportTokenTransportKey(context)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error retrieving import token public key: %s", err)
+	}
+
+	// Import tokens may be returned without an ID, there is a single active token per instance.
+	tokenID := importToken.ID
+	if tokenID == "" && importToken.CreationDate != nil {
+		tokenID = fmt.Sprintf("%d", importToken.CreationDate.Unix())
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, tokenID))
+	if importToken.CreationDate != nil {
+		d.Set("creation_date", importToken.CreationDate.Format(time.RFC3339))
+	}
+	if importToken.ExpirationDate != nil {
+		d.Set("expiration_date", importToken.ExpirationDate.Format(time.RFC3339))
+	}
+	// The public key was retrieved once above
+	d.Set("remaining_retrievals", importToken.RemainingRetrievals-1)
+	d.Set("public_key", transportKey.Payload)
+	d.Set("nonce", transportKey.Nonce)
+
+	if keyMaterial, ok := d.GetOk("key_material"); ok {
+		encryptedPayload, err := kp.EncryptKey(keyMaterial.(string), transportKey.Payload)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error encrypting the key material: %s", err)
+		}
+		encryptedNonce, iv, err := kp.EncryptNonce(keyMaterial.(string), transportKey.Nonce, "")
+		if err != nil {
+			return diag.Errorf("[ERROR] Error encrypting the nonce: %s", err)
+		}
+		d.Set("encrypted_payload", encryptedPayload)
+		d.Set("encrypted_nonce", encryptedNonce)
+		d.Set("iv_value", iv)
+	}
+
+	return resourceIBMKmsImportTokenRead(context, d, meta)
+}
+
+func resourceIBMKmsImportTokenRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Retrieving the import token consumes one of its retrievals, the values
+	// are kept as they were at creation. A new token is only created when the
+	// arguments change, keys imported with an expired token are not affected.
+	return nil
+}
+
+func resourceIBMKmsImportTokenDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Import tokens can't be deleted, they expire.
+	d.SetId("")
+	return nil
+}
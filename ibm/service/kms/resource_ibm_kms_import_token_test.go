@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSImportToken_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	// 256 bits AES key material
+	keyMaterial := "Rm5tcC9yMFFpU0FDbUhMcWJLSE1oTnlHQkNYeThKTnQ="
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.token", "public_key"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.token", "nonce"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.token", "encrypted_nonce"),
+					resource.TestCheckResourceAttrSet("ibm_kms_import_token.token", "iv_value"),
+					resource.TestCheckResourceAttr("ibm_kms_import_token.token", "remaining_retrievals", "0"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterial string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_import_token" "token" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_material = "%s"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id     = ibm_resource_instance.kp_instance.guid
+		key_name        = "%s"
+		standard_key    = false
+		payload         = ibm_kms_import_token.token.encrypted_payload
+		encrypted_nonce = ibm_kms_import_token.token.encrypted_nonce
+		iv_value        = ibm_kms_import_token.token.iv_value
+	}
+`, instanceName, keyMaterial, keyName)
+}
@@ -0,0 +1,448 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesCreate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMKmsInstancePoliciesManagedDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Default:      "public",
+				Description:  "public or private",
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the dual authorization delete policy of the instance",
+				Elem: &schema.Resource{
+					Schema: kmsInstancePolicySchema(map[string]*schema.Schema{}),
+				},
+			},
+			"allowed_network": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the allowed network policy of the instance",
+				Elem: &schema.Resource{
+					Schema: kmsInstancePolicySchema(map[string]*schema.Schema{
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public-and-private", "private-only"}),
+							Description:  "The type of the allowed network, public-and-private or private-only",
+						},
+					}),
+				},
+			},
+			"allowed_ip": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the allowed IP policy of the instance",
+				Elem: &schema.Resource{
+					Schema: kmsInstancePolicySchema(map[string]*schema.Schema{
+						"ip_addresses": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The IPv4 or IPv6 addresses or CIDR ranges that are allowed to access the instance",
+						},
+					}),
+				},
+			},
+			"key_create_import_access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the key create and import access policy of the instance",
+				Elem: &schema.Resource{
+					Schema: kmsInstancePolicySchema(map[string]*schema.Schema{
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If true, root keys can be created in the instance",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If true, standard keys can be created in the instance",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If true, root keys can be imported in the instance",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If true, standard keys can be imported in the instance",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If true, keys can only be imported with an import token",
+						},
+					}),
+				},
+			},
+			"managed_policies": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The policies that are set in the configuration, only these are disabled when the resource is destroyed",
+			},
+			"metrics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the metrics policy of the instance",
+				Elem: &schema.Resource{
+					Schema: kmsInstancePolicySchema(map[string]*schema.Schema{}),
+				},
+			},
+		},
+	}
+}
+
+var kmsInstancePolicyTypes = []string{"dual_auth_delete", "allowed_network", "allowed_ip", "key_create_import_access", "metrics"}
+
+// kmsInstancePolicySchema adds the attributes shared by all the instance policies to policySchema
+func kmsInstancePolicySchema(policySchema map[string]*schema.Schema) map[string]*schema.Schema {
+	policySchema["enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "If true, the policy is enabled",
+	}
+	policySchema["created_by"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier for the resource that created the policy",
+	}
+	policySchema["creation_date"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The date the policy was created. The date format follows RFC 3339",
+	}
+	policySchema["updated_by"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The unique identifier for the resource that updated the policy",
+	}
+	policySchema["last_updated"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Updates when the policy is replaced or modified. The date format follows RFC 3339",
+	}
+	return policySchema
+}
+
+func resourceIBMKmsInstancePoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies := expandKmsInstancePolicies(d, kmsInstancePolicyTypes)
+	err = setKmsInstancePolicies(context, kpAPI, policies)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while creating instance policies: %s", err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Id(), endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := kpAPI.GetInstancePolicies(context)
+	if err != nil {
+		if isKMSNotFound(err) {
+			log.Printf("[WARN] Removing instance policies (%s) from state because they're not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error while retrieving instance policies: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	for _, policyType := range kmsInstancePolicyTypes {
+		d.Set(policyType, []map[string]interface{}{})
+	}
+	for _, policy := range policies {
+		if policyType, ok := kmsInstancePolicyTypeNames[policy.PolicyType]; ok {
+			d.Set(policyType, flattenKmsInstancePolicy(policy))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := kmsClientForInstance(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	changed := []string{}
+	for _, policyType := range kmsInstancePolicyTypes {
+		if d.HasChange(policyType) {
+			changed = append(changed, policyType)
+		}
+	}
+	if len(changed) > 0 {
+		err = setKmsInstancePolicies(context, kpAPI, expandKmsInstancePolicies(d, changed))
+		if err != nil {
+			return diag.Errorf("[ERROR] Error while updating instance policies: %s", err)
+		}
+	}
+
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := kmsClientForInstance(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Instance policies can't be deleted, the policies set in the configuration are disabled.
+	// The other policies are only in the state as they are Computed, they are left as is.
+	managed := flex.ExpandStringList(d.Get("managed_policies").(*schema.Set).List())
+	if len(managed) == 0 {
+		log.Printf("[WARN] Instance policies (%s) have never been applied, no policy is disabled", d.Id())
+		d.SetId("")
+		return nil
+	}
+	policies := expandKmsInstancePolicies(d, managed)
+	if policies.DualAuthDelete != nil {
+		policies.DualAuthDelete.Enabled = false
+	}
+	if policies.AllowedNetwork != nil {
+		policies.AllowedNetwork.Enabled = false
+	}
+	if policies.AllowedIP != nil {
+		policies.AllowedIP.Enabled = false
+		policies.AllowedIP.IPAddresses = nil
+	}
+	if policies.KeyCreateImportAccess != nil {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{Enabled: false}
+	}
+	if policies.Metrics != nil {
+		policies.Metrics.Enabled = false
+	}
+	err = setKmsInstancePolicies(context, kpAPI, policies)
+	if err != nil && !isKMSNotFound(err) {
+		return diag.Errorf("[ERROR] Error while disabling instance policies: %s", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// setKmsInstancePolicies sets policies on the instance. The key create import
+// access policy is set on its own, SetInstancePolicies drops its false attributes.
+func setKmsInstancePolicies(context context.Context, kpAPI *kp.Client, policies kp.MultiplePolicies) error {
+	keyAccess := policies.KeyCreateImportAccess
+	policies.KeyCreateImportAccess = nil
+	if policies.DualAuthDelete != nil || policies.AllowedNetwork != nil || policies.AllowedIP != nil || policies.Metrics != nil {
+		if err := kpAPI.SetInstancePolicies(context, policies); err != nil {
+			return err
+		}
+	}
+	if keyAccess != nil {
+		attributes := map[string]bool{
+			kp.CreateRootKey:     keyAccess.CreateRootKey,
+			kp.CreateStandardKey: keyAccess.CreateStandardKey,
+			kp.ImportRootKey:     keyAccess.ImportRootKey,
+			kp.ImportStandardKey: keyAccess.ImportStandardKey,
+			kp.EnforceToken:      keyAccess.EnforceToken,
+		}
+		return kpAPI.SetKeyCreateImportAccessInstancePolicy(context, keyAccess.Enabled, attributes)
+	}
+	return nil
+}
+
+// resourceIBMKmsInstancePoliciesManagedDiff records the policies that are set
+// in the configuration, as the configuration is not available on destroy
+func resourceIBMKmsInstancePoliciesManagedDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	managed := []interface{}{}
+	for _, policyType := range kmsInstancePolicyTypes {
+		policy := config.GetAttr(policyType)
+		if !policy.IsNull() && policy.IsKnown() && policy.LengthInt() > 0 {
+			managed = append(managed, policyType)
+		}
+	}
+	newManaged := schema.NewSet(schema.HashString, managed)
+	if oldManaged, ok := diff.Get("managed_policies").(*schema.Set); ok && oldManaged.Equal(newManaged) {
+		return nil
+	}
+	return diff.SetNew("managed_policies", newManaged)
+}
+
+// kmsInstancePolicyTypeNames maps the policy types of the API to the attributes of the resource
+var kmsInstancePolicyTypeNames = map[string]string{
+	kp.DualAuthDelete:        "dual_auth_delete",
+	kp.AllowedNetwork:        "allowed_network",
+	kp.AllowedIP:             "allowed_ip",
+	kp.KeyCreateImportAccess: "key_create_import_access",
+	kp.Metrics:               "metrics",
+}
+
+// expandKmsInstancePolicies builds the request for the policyTypes that are set in the configuration
+func expandKmsInstancePolicies(d *schema.ResourceData, policyTypes []string) kp.MultiplePolicies {
+	policies := kp.MultiplePolicies{}
+	for _, policyType := range policyTypes {
+		policyList := d.Get(policyType).([]interface{})
+		if len(policyList) == 0 || policyList[0] == nil {
+			continue
+		}
+		policy := policyList[0].(map[string]interface{})
+		enabled := policy["enabled"].(bool)
+		switch policyType {
+		case "dual_auth_delete":
+			policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: enabled}
+		case "allowed_network":
+			policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+				Enabled: enabled,
+				Network: policy["network"].(string),
+			}
+		case "allowed_ip":
+			policies.AllowedIP = &kp.AllowedIPPolicyData{
+				Enabled:     enabled,
+				IPAddresses: flex.ExpandStringList(policy["ip_addresses"].(*schema.Set).List()),
+			}
+		case "key_create_import_access":
+			policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+				Enabled:           enabled,
+				CreateRootKey:     policy["create_root_key"].(bool),
+				CreateStandardKey: policy["create_standard_key"].(bool),
+				ImportRootKey:     policy["import_root_key"].(bool),
+				ImportStandardKey: policy["import_standard_key"].(bool),
+				EnforceToken:      policy["enforce_token"].(bool),
+			}
+		case "metrics":
+			policies.Metrics = &kp.BasicPolicyData{Enabled: enabled}
+		}
+	}
+	return policies
+}
+
+func flattenKmsInstancePolicy(policy kp.InstancePolicy) []map[string]interface{} {
+	p := map[string]interface{}{
+		"created_by": policy.CreatedBy,
+		"updated_by": policy.UpdatedBy,
+	}
+	if policy.PolicyData.Enabled != nil {
+		p["enabled"] = *policy.PolicyData.Enabled
+	}
+	if policy.CreatedAt != nil {
+		p["creation_date"] = policy.CreatedAt.Format(time.RFC3339)
+	}
+	if policy.UpdatedAt != nil {
+		p["last_updated"] = policy.UpdatedAt.Format(time.RFC3339)
+	}
+
+	attributes := policy.PolicyData.Attributes
+	switch policy.PolicyType {
+	case kp.AllowedNetwork:
+		if attributes != nil && attributes.AllowedNetwork != nil {
+			p["network"] = *attributes.AllowedNetwork
+		}
+	case kp.AllowedIP:
+		if attributes != nil {
+			p["ip_addresses"] = flex.NewStringSet(schema.HashString, attributes.AllowedIP)
+		}
+	case kp.KeyCreateImportAccess:
+		// The attributes are only returned while the policy is enabled, the API defaults apply otherwise
+		p["create_root_key"] = true
+		p["create_standard_key"] = true
+		p["import_root_key"] = true
+		p["import_standard_key"] = true
+		p["enforce_token"] = false
+		if attributes != nil {
+			if attributes.CreateRootKey != nil {
+				p["create_root_key"] = *attributes.CreateRootKey
+			}
+			if attributes.CreateStandardKey != nil {
+				p["create_standard_key"] = *attributes.CreateStandardKey
+			}
+			if attributes.ImportRootKey != nil {
+				p["import_root_key"] = *attributes.ImportRootKey
+			}
+			if attributes.ImportStandardKey != nil {
+				p["import_standard_key"] = *attributes.ImportStandardKey
+			}
+			if attributes.EnforceToken != nil {
+				p["enforce_token"] = *attributes.EnforceToken
+			}
+		}
+	}
+	return []map[string]interface{}{p}
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSInstancePolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.enforce_token", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.import_standard_key", "false"),
+					resource.TestCheckResourceAttrSet("ibm_kms_instance_policies.policies", "metrics.0.creation_date"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "managed_policies.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "metrics.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.policies", "key_create_import_access.0.enforce_token", "true"),
+				),
+			},
+			{
+				ResourceName:            "ibm_kms_instance_policies.policies",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_id", "managed_policies"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, metrics, enforceToken bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		metrics {
+			enabled = %t
+		}
+		key_create_import_access {
+			enabled             = true
+			import_standard_key = false
+			enforce_token       = %t
+		}
+	}
+`, instanceName, metrics, enforceToken)
+}
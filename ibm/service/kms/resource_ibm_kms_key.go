@@ -4,9 +4,16 @@
 package kms
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
 	"net/url"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +22,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/IBM/keyprotect-go-client/iam"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -449,11 +457,19 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 //Construct KMS URL
 func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string]interface{}) (*url.URL, error) {
 
-	exturl := extensions["endpoints"].(map[string]interface{})["public"]
 	if endpointType == "private" || strings.Contains(kpAPI.Config.BaseURL, "private") {
-		exturl = extensions["endpoints"].(map[string]interface{})["private"]
+		endpointType = "private"
+	} else {
+		endpointType = "public"
+	}
+	exturl := kmsInstanceEndpoint(extensions, endpointType)
+	if exturl == "" && os.Getenv("IBMCLOUD_KP_API_ENDPOINT") == "" {
+		if ep11 := instanceEndpoint(extensions, endpointType, true); ep11 != "" {
+			return nil, fmt.Errorf("[ERROR] Error retrieving the %s KMS endpoint of the instance, the instance only has the EP11 endpoint %s which doesn't serve the KMS API", endpointType, ep11)
+		}
+		return nil, fmt.Errorf("[ERROR] Error retrieving the %s KMS endpoint of the instance", endpointType)
 	}
-	endpointURL := fmt.Sprintf("%s/api/v2/keys", exturl.(string))
+	endpointURL := fmt.Sprintf("%s/api/v2/keys", exturl)
 
 	url1 := conns.EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, endpointURL)
 	u, err := url.Parse(url1)
@@ -462,3 +478,153 @@ func KmsEndpointURL(kpAPI *kp.Client, endpointType string, extensions map[string
 	}
 	return u, nil
 }
+
+// kmsInstanceEndpoint returns the KMS endpoint of endpointType from the extensions
+// of a Key Protect or HPCS instance. HPCS instances also list their EP11 endpoints,
+// which don't serve the KMS API.
+func kmsInstanceEndpoint(extensions map[string]interface{}, endpointType string) string {
+	return instanceEndpoint(extensions, endpointType, false)
+}
+
+// instanceEndpoint returns the EP11 or the KMS endpoint of endpointType from
+// the extensions of an instance. The endpoints are either listed by name, such
+// as public or ep11-public, or grouped by API, such as kms.public or ep11.public.
+func instanceEndpoint(extensions map[string]interface{}, endpointType string, ep11 bool) string {
+	endpoints, ok := extensions["endpoints"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	group := "kms"
+	if ep11 {
+		group = "ep11"
+	}
+	endpoint := ""
+	if grouped, ok := endpoints[group].(map[string]interface{}); ok {
+		endpoint, _ = grouped[endpointType].(string)
+	}
+	if endpoint == "" && !ep11 {
+		endpoint, _ = endpoints[endpointType].(string)
+	}
+	if endpoint == "" {
+		names := make([]string, 0, len(endpoints))
+		for name := range endpoints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if strings.Contains(name, endpointType) && strings.Contains(name, "ep11") == ep11 {
+				if e, ok := endpoints[name].(string); ok && e != "" {
+					endpoint = e
+					break
+				}
+			}
+		}
+	}
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return strings.TrimSuffix(endpoint, "/")
+}
+
+// kmsClientForInstance returns a KMS client configured for the given Key Protect or
+// HPCS instance, instanceID can be the GUID or the CRN of the instance.
+func kmsClientForInstance(meta interface{}, instanceID, endpointType string) (*kp.Client, string, error) {
+	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
+	if err != nil {
+		return nil, "", err
+	}
+	crnData := strings.Split(instanceID, ":")
+	if len(crnData) > 3 {
+		instanceID = crnData[len(crnData)-3]
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, "", err
+	}
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	if err != nil || instanceData == nil {
+		return nil, "", fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+	}
+	URL, err := KmsEndpointURL(kpAPI, endpointType, instanceData.Extensions)
+	if err != nil {
+		return nil, "", err
+	}
+	kpAPI.URL = URL
+	kpAPI.Config.InstanceID = instanceID
+
+	return kpAPI, instanceID, nil
+}
+
+// kmsRequest sends a request for the APIs that are not implemented by the
+// keyprotect client. path is relative to the /api/v2/ endpoint of the instance.
+func kmsRequest(ctx context.Context, kpAPI *kp.Client, method, path string, body, res interface{}) error {
+	u, err := kpAPI.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+
+	token := kpAPI.Config.Authorization
+	if token == "" {
+		tokenSource := iam.CredentialFromAPIKey(kpAPI.Config.APIKey)
+		if kpAPI.Config.TokenURL != "" {
+			tokenSource.TokenURL = kpAPI.Config.TokenURL
+		}
+		t, err := tokenSource.Token()
+		if err != nil {
+			return err
+		}
+		token = fmt.Sprintf("%s %s", t.TokenType, t.AccessToken)
+	}
+	req.Header.Set("accept", "application/json")
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("authorization", token)
+	req.Header.Set("bluemix-instance", kpAPI.Config.InstanceID)
+
+	response, err := kpAPI.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	resBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode >= 300 {
+		return &kp.Error{
+			URL:         u.String(),
+			StatusCode:  response.StatusCode,
+			Message:     string(resBody),
+			BodyContent: resBody,
+		}
+	}
+	if res != nil && len(resBody) > 0 {
+		return json.Unmarshal(resBody, res)
+	}
+	return nil
+}
+
+// isKMSNotFound returns true when err is a KMS 404 error.
+func isKMSNotFound(err error) bool {
+	if kpError, ok := err.(*kp.Error); ok {
+		return kpError.StatusCode == 404
+	}
+	return false
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// kmsKeyDualAuthDelete is the dual authorization state of a key, the
+// keyprotect client only returns whether the policy is enabled.
+type kmsKeyDualAuthDelete struct {
+	Enabled           *bool      `json:"enabled,omitempty"`
+	KeySetForDeletion *bool      `json:"keySetForDeletion,omitempty"`
+	AuthExpiration    *time.Time `json:"authExpiration,omitempty"`
+}
+
+type kmsKeyDualAuthMetadata struct {
+	Keys []struct {
+		ID             string                `json:"id"`
+		Deleted        *bool                 `json:"deleted,omitempty"`
+		DualAuthDelete *kmsKeyDualAuthDelete `json:"dualAuthDelete,omitempty"`
+	} `json:"resources"`
+}
+
+func ResourceIBMKmsKeyDeletionAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyDeletionAuthorizationCreate,
+		ReadContext:   resourceIBMKmsKeyDeletionAuthorizationRead,
+		DeleteContext: resourceIBMKmsKeyDeletionAuthorizationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the key to authorize for deletion",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Default:      "public",
+				Description:  "public or private",
+			},
+			"key_set_for_deletion": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If true, the key is authorized for deletion by a second user",
+			},
+			"auth_expiration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the authorization for deletion expires, the key has to be deleted before it",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyDeletionAuthorizationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+
+	err = kpAPI.InitiateDualAuthDelete(context, keyID)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error setting key (%s) for deletion: %s", keyID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, keyID))
+
+	return resourceIBMKmsKeyDeletionAuthorizationRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyDeletionAuthorizationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, keyID, err := parseKmsKeyDeletionAuthorizationID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kpAPI, _, err := kmsClientForInstance(meta, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := kmsKeyDualAuthMetadata{}
+	err = kmsRequest(context, kpAPI, "GET", fmt.Sprintf("keys/%s/metadata", keyID), nil, &metadata)
+	if err != nil {
+		if isKMSNotFound(err) || isKMSKeyGone(err) {
+			log.Printf("[WARN] Removing key (%s) deletion authorization from state because the key is not found", keyID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving key (%s): %s", keyID, err)
+	}
+	if len(metadata.Keys) == 0 || (metadata.Keys[0].Deleted != nil && *metadata.Keys[0].Deleted) {
+		log.Printf("[WARN] Removing key (%s) deletion authorization from state because the key is deleted", keyID)
+		d.SetId("")
+		return nil
+	}
+	dualAuth := metadata.Keys[0].DualAuthDelete
+	// An authorization that expired or was cancelled is authorized again on the next apply
+	if dualAuth == nil || dualAuth.KeySetForDeletion == nil || !*dualAuth.KeySetForDeletion {
+		log.Printf("[WARN] Removing key (%s) deletion authorization from state because the key is no longer set for deletion", keyID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("key_set_for_deletion", *dualAuth.KeySetForDeletion)
+	if dualAuth.AuthExpiration != nil {
+		d.Set("auth_expiration", dualAuth.AuthExpiration.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKeyDeletionAuthorizationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, keyID, err := parseKmsKeyDeletionAuthorizationID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsClientForInstance(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kpAPI.CancelDualAuthDelete(context, keyID)
+	if err != nil && !isKMSNotFound(err) && !isKMSKeyGone(err) {
+		return diag.Errorf("[ERROR] Error unsetting key (%s) for deletion: %s", keyID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// isKMSKeyGone returns true when err is returned for a key that was already deleted.
+func isKMSKeyGone(err error) bool {
+	if kpError, ok := err.(*kp.Error); ok {
+		return kpError.StatusCode == 410
+	}
+	return false
+}
+
+// parseKmsKeyDeletionAuthorizationID splits an ID of the form <instance_id>/<key_id>
+func parseKmsKeyDeletionAuthorizationID(id string) (instanceID, keyID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/keyID", id)
+	}
+	return parts[0], parts[1], nil
+}
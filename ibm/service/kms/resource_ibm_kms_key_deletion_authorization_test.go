@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyDeletionAuthorization_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyDeletionAuthorizationConfig(instanceName, keyName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_deletion_authorization.authorization", "key_set_for_deletion", "true"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_deletion_authorization.authorization", "auth_expiration"),
+				),
+			},
+			{
+				ResourceName:      "ibm_kms_key_deletion_authorization.authorization",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// The same user can't authorize and delete the key, the authorization
+			// is cancelled and dual authorization disabled before destroy
+			{
+				Config: testAccCheckIBMKmsKeyDeletionAuthorizationConfig(instanceName, keyName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_policies.policy", "dual_auth_delete.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyDeletionAuthorizationConfig(instanceName, keyName string, authorize bool) string {
+	config := fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+	}
+
+	resource "ibm_kms_key_policies" "policy" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+		dual_auth_delete {
+			enabled = %t
+		}
+	}
+`, instanceName, keyName, authorize)
+	if authorize {
+		config += `
+	resource "ibm_kms_key_deletion_authorization" "authorization" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		key_id      = ibm_kms_key_policies.policy.key_id
+	}
+`
+	}
+	return config
+}
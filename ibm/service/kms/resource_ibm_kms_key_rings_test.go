@@ -49,6 +49,26 @@ func TestAccIBMKMSResource_Key_Ring_Key(t *testing.T) {
 	})
 }
 
+func TestAccIBMKMSResource_Key_Ring_HPCS(t *testing.T) {
+	keyRing := fmt.Sprintf("keyRing%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("hpcs_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceKeyRingHpcsConfig(acc.HpcsInstanceID, keyRing, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_rings.hpcs", "key_ring_id", keyRing),
+					resource.TestCheckResourceAttr("ibm_kms_key.hpcs", "key_ring_id", keyRing),
+					resource.TestCheckResourceAttr("ibm_kms_key.hpcs", "type", "hs-crypto"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMKMSResource_Key_Ring_Not_Exist(t *testing.T) {
 	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
 	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
@@ -120,3 +140,19 @@ func testAccCheckIBMKmsResourceKeyRingExistConfig(instanceName, keyName, keyRing
 	}
 `, instanceName, keyRing, keyName)
 }
+
+func testAccCheckIBMKmsResourceKeyRingHpcsConfig(hpcsInstanceID, keyRing, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_kms_key_rings" "hpcs" {
+		instance_id = "%s"
+		key_ring_id = "%s"
+	}
+	resource "ibm_kms_key" "hpcs" {
+		instance_id  = ibm_kms_key_rings.hpcs.instance_id
+		key_ring_id  = ibm_kms_key_rings.hpcs.key_ring_id
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+`, hpcsInstanceID, keyRing, keyName)
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyRotationCreate,
+		ReadContext:   resourceIBMKmsKeyRotationRead,
+		DeleteContext: resourceIBMKmsKeyRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the root key to rotate",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Default:      "public",
+				Description:  "public or private",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new key material of an imported root key, base64 encoded or encrypted with an import token",
+			},
+			"encrypted_nonce": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "iv_value"},
+				Description:  "The encrypted nonce of the import token used to encrypt the payload",
+			},
+			"iv_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "encrypted_nonce"},
+				Description:  "The initialization vector used to encrypt the nonce",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, rotates the key again",
+			},
+			"key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key version created by the rotation",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+	payload := d.Get("payload").(string)
+
+	if nonce, ok := d.GetOk("encrypted_nonce"); ok {
+		// The keyprotect client only sends the payload on rotate
+		rotateRequest := map[string]string{
+			"payload":        payload,
+			"encryptedNonce": nonce.(string),
+			"iv":             d.Get("iv_value").(string),
+		}
+		err = kmsRequest(context, kpAPI, "POST", fmt.Sprintf("keys/%s?action=rotate", keyID), rotateRequest, nil)
+	} else {
+		err = kpAPI.Rotate(context, keyID, payload)
+	}
+	if err != nil {
+		return diag.Errorf("[ERROR] Error rotating key (%s): %s", keyID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", instanceID, keyID, time.Now().Unix()))
+
+	return resourceIBMKmsKeyRotationRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+
+	key, err := kpAPI.GetKeyMetadata(context, keyID)
+	if err != nil {
+		if isKMSNotFound(err) {
+			log.Printf("[WARN] Removing key (%s) rotation from state because the key is not found", keyID)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving key (%s): %s", keyID, err)
+	}
+	if key.Deleted != nil && *key.Deleted {
+		log.Printf("[WARN] Removing key (%s) rotation from state because the key is deleted", keyID)
+		d.SetId("")
+		return nil
+	}
+
+	// The rotation is a one-time action, only the first version seen after it is recorded.
+	if _, ok := d.GetOk("key_version_id"); !ok && key.KeyVersion != nil {
+		d.Set("key_version_id", key.KeyVersion.ID)
+	}
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKeyRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A rotation can't be undone, the previous key versions remain available for unwrap.
+	log.Printf("[WARN] Removing key (%s) rotation from state, the key keeps its current version", d.Get("key_id").(string))
+	d.SetId("")
+	return nil
+}
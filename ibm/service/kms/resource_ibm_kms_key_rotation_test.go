@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyRotation_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.rotation", "key_version_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.rotation", "last_rotate_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key_rotation.rotation", "triggers.version", "2"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key_rotation.rotation", "key_version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyRotationConfig(instanceName, keyName, version string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+	}
+
+	resource "ibm_kms_key_rotation" "rotation" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+		triggers = {
+			version = "%s"
+		}
+	}
+`, instanceName, keyName, version)
+}
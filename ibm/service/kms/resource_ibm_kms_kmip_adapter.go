@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	kmipAdapterCollectionType = "application/vnd.ibm.kms.kmip_adapter+json"
+	kmipCertCollectionType    = "application/vnd.ibm.kms.kmip_client_certificate+json"
+)
+
+// kmipCollectionMetadata is the metadata of the KMIP collections
+type kmipCollectionMetadata struct {
+	CollectionType  string `json:"collectionType"`
+	CollectionTotal int    `json:"collectionTotal"`
+}
+
+type kmipAdapter struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Profile     string            `json:"profile,omitempty"`
+	ProfileData map[string]string `json:"profile_data,omitempty"`
+	CreatedAt   *time.Time        `json:"created_at,omitempty"`
+	CreatedBy   string            `json:"created_by,omitempty"`
+	UpdatedAt   *time.Time        `json:"updated_at,omitempty"`
+	UpdatedBy   string            `json:"updated_by,omitempty"`
+}
+
+type kmipAdapters struct {
+	Metadata kmipCollectionMetadata `json:"metadata"`
+	Adapters []kmipAdapter          `json:"resources"`
+}
+
+func ResourceIBMKmsKmipAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKmipAdapterCreate,
+		ReadContext:   resourceIBMKmsKmipAdapterRead,
+		DeleteContext: resourceIBMKmsKmipAdapterDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Default:      "public",
+				Description:  "public or private",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 40),
+				Description:  "The name of the KMIP adapter, a name is generated when it is not provided",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 240),
+				Description:  "The description of the KMIP adapter",
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "native_1.0",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"native_1.0"}),
+				Description:  "The profile of the KMIP adapter",
+			},
+			"profile_data": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The data of the profile, the native_1.0 profile requires the crk_id of the root key used by the adapter",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the KMIP adapter",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was created",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource that created the KMIP adapter",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was last updated",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource that last updated the KMIP adapter",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKmipAdapterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adapter := kmipAdapter{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Profile:     d.Get("profile").(string),
+		ProfileData: make(map[string]string),
+	}
+	for k, v := range d.Get("profile_data").(map[string]interface{}) {
+		adapter.ProfileData[k] = v.(string)
+	}
+	createRequest := kmipAdapters{
+		Metadata: kmipCollectionMetadata{
+			CollectionType:  kmipAdapterCollectionType,
+			CollectionTotal: 1,
+		},
+		Adapters: []kmipAdapter{adapter},
+	}
+
+	created := kmipAdapters{}
+	err = kmsRequest(context, kpAPI, "POST", "kmip_adapters", createRequest, &created)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error creating KMIP adapter: %s", err)
+	}
+	if len(created.Adapters) == 0 {
+		return diag.Errorf("[ERROR] Error creating KMIP adapter: no adapter returned")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, created.Adapters[0].ID))
+
+	return resourceIBMKmsKmipAdapterRead(context, d, meta)
+}
+
+func resourceIBMKmsKmipAdapterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, adapterID, err := parseKmsKmipAdapterID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsClientForInstance(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adapters := kmipAdapters{}
+	err = kmsRequest(context, kpAPI, "GET", fmt.Sprintf("kmip_adapters/%s", adapterID), nil, &adapters)
+	if err != nil {
+		if isKMSNotFound(err) {
+			log.Printf("[WARN] Removing KMIP adapter (%s) from state because it's not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving KMIP adapter (%s): %s", adapterID, err)
+	}
+	if len(adapters.Adapters) == 0 {
+		log.Printf("[WARN] Removing KMIP adapter (%s) from state because it's not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	adapter := adapters.Adapters[0]
+
+	d.Set("instance_id", instanceID)
+	if _, ok := d.GetOk("endpoint_type"); !ok {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("adapter_id", adapter.ID)
+	d.Set("name", adapter.Name)
+	d.Set("description", adapter.Description)
+	d.Set("profile", adapter.Profile)
+	d.Set("profile_data", adapter.ProfileData)
+	if adapter.CreatedAt != nil {
+		d.Set("created_at", adapter.CreatedAt.Format(time.RFC3339))
+	}
+	d.Set("created_by", adapter.CreatedBy)
+	if adapter.UpdatedAt != nil {
+		d.Set("updated_at", adapter.UpdatedAt.Format(time.RFC3339))
+	}
+	d.Set("updated_by", adapter.UpdatedBy)
+
+	return nil
+}
+
+func resourceIBMKmsKmipAdapterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, adapterID, err := parseKmsKmipAdapterID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsClientForInstance(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kmsRequest(context, kpAPI, "DELETE", fmt.Sprintf("kmip_adapters/%s", adapterID), nil, nil)
+	if err != nil && !isKMSNotFound(err) {
+		return diag.Errorf("[ERROR] Error deleting KMIP adapter (%s): %s", adapterID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseKmsKmipAdapterID splits an ID of the form <instance_id>/<adapter_id>
+func parseKmsKmipAdapterID(id string) (instanceID, adapterID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/adapterID", id)
+	}
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKmipAdapter_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("adapter-%d", acctest.RandIntRange(10, 100))
+	certificate := testAccKmsKmipClientCertificatePEM(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKmipAdapterConfig(instanceName, keyName, adapterName, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "name", adapterName),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.adapter", "profile", "native_1.0"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_adapter.adapter", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.cert", "cert_id"),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_client_cert.cert", "name", "cert-1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_kms_kmip_adapter.adapter",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ibm_kms_kmip_client_cert.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccKmsKmipClientCertificatePEM returns a self signed client certificate
+func testAccKmsKmipClientCertificatePEM(t *testing.T) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kmip-client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testAccCheckIBMKmsKmipAdapterConfig(instanceName, keyName, adapterName, certificate string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+	}
+
+	resource "ibm_kms_kmip_adapter" "adapter" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		name        = "%s"
+		description = "terraform acceptance test"
+		profile_data = {
+			crk_id = ibm_kms_key.test.key_id
+		}
+	}
+
+	resource "ibm_kms_kmip_client_cert" "cert" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		adapter_id  = ibm_kms_kmip_adapter.adapter.adapter_id
+		certificate = <<EOT
+%sEOT
+		name        = "cert-1"
+	}
+`, instanceName, keyName, adapterName, certificate)
+}
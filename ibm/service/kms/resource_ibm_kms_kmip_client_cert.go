@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+type kmipClientCertificate struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Certificate string     `json:"certificate,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+}
+
+type kmipClientCertificates struct {
+	Metadata     kmipCollectionMetadata  `json:"metadata"`
+	Certificates []kmipClientCertificate `json:"resources"`
+}
+
+func ResourceIBMKmsKmipClientCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKmipClientCertificateCreate,
+		ReadContext:   resourceIBMKmsKmipClientCertificateRead,
+		DeleteContext: resourceIBMKmsKmipClientCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID or CRN",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Default:      "public",
+				Description:  "public or private",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KMIP adapter",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PEM encoded client certificate",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 40),
+				Description:  "The name of the client certificate, a name is generated when it is not provided",
+			},
+			"cert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the client certificate",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the client certificate was created",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the resource that created the client certificate",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKmipClientCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceID, err := kmsClientForInstance(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adapterID := d.Get("adapter_id").(string)
+
+	createRequest := kmipClientCertificates{
+		Metadata: kmipCollectionMetadata{
+			CollectionType:  kmipCertCollectionType,
+			CollectionTotal: 1,
+		},
+		Certificates: []kmipClientCertificate{
+			{
+				Name:        d.Get("name").(string),
+				Certificate: d.Get("certificate").(string),
+			},
+		},
+	}
+
+	created := kmipClientCertificates{}
+	err = kmsRequest(context, kpAPI, "POST", fmt.Sprintf("kmip_adapters/%s/certificates", adapterID), createRequest, &created)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error creating KMIP client certificate: %s", err)
+	}
+	if len(created.Certificates) == 0 {
+		return diag.Errorf("[ERROR] Error creating KMIP client certificate: no certificate returned")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, adapterID, created.Certificates[0].ID))
+
+	return resourceIBMKmsKmipClientCertificateRead(context, d, meta)
+}
+
+func resourceIBMKmsKmipClientCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, adapterID, certID, err := parseKmsKmipClientCertificateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsClientForInstance(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	certificates := kmipClientCertificates{}
+	err = kmsRequest(context, kpAPI, "GET", fmt.Sprintf("kmip_adapters/%s/certificates/%s", adapterID, certID), nil, &certificates)
+	if err != nil {
+		if isKMSNotFound(err) {
+			log.Printf("[WARN] Removing KMIP client certificate (%s) from state because it's not found", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error retrieving KMIP client certificate (%s): %s", certID, err)
+	}
+	if len(certificates.Certificates) == 0 {
+		log.Printf("[WARN] Removing KMIP client certificate (%s) from state because it's not found", d.Id())
+		d.SetId("")
+		return nil
+	}
+	certificate := certificates.Certificates[0]
+
+	d.Set("instance_id", instanceID)
+	if _, ok := d.GetOk("endpoint_type"); !ok {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("adapter_id", adapterID)
+	d.Set("cert_id", certificate.ID)
+	d.Set("name", certificate.Name)
+	d.Set("certificate", certificate.Certificate)
+	if certificate.CreatedAt != nil {
+		d.Set("created_at", certificate.CreatedAt.Format(time.RFC3339))
+	}
+	d.Set("created_by", certificate.CreatedBy)
+
+	return nil
+}
+
+func resourceIBMKmsKmipClientCertificateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, adapterID, certID, err := parseKmsKmipClientCertificateID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	kpAPI, _, err := kmsClientForInstance(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kmsRequest(context, kpAPI, "DELETE", fmt.Sprintf("kmip_adapters/%s/certificates/%s", adapterID, certID), nil, nil)
+	if err != nil && !isKMSNotFound(err) {
+		return diag.Errorf("[ERROR] Error deleting KMIP client certificate (%s): %s", certID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseKmsKmipClientCertificateID splits an ID of the form <instance_id>/<adapter_id>/<cert_id>
+func parseKmsKmipClientCertificateID(id string) (instanceID, adapterID, certID string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of instanceID/adapterID/certID", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,244 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMCatalogPricing() *schema.Resource {
+	return &schema.Resource{
+		Read: DataSourceIBMCatalogPricingRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Description: "The name of the service offering like cloud-object-storage, databases-for-postgresql etc",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"plan": {
+				Description: "The name of the plan of the service offering",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"location": {
+				Description: "The location where instances of the plan are created",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"country": {
+				Description: "The country code of the prices, in the ISO 3166-1 alpha-3 format",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "USA",
+			},
+
+			"plan_id": {
+				Description: "The ID of the plan",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"deployment_id": {
+				Description: "The ID of the deployment of the plan at the location",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"type": {
+				Description: "The pricing type of the plan, like free, paygo or subscription",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"origin": {
+				Description: "Where the pricing originates",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"currency": {
+				Description: "The currency of the prices",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"starting_price": {
+				Description: "The lowest price of the plan",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+
+			"starting_price_unit": {
+				Description: "The unit of the starting price",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"metrics": {
+				Description: "The metrics the plan is charged by",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_id": {
+							Description: "The ID of the metric",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"part_ref": {
+							Description: "The part reference of the metric",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tier_model": {
+							Description: "The pricing tier model of the metric, like Linear, Granular Tier or Step Tier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit": {
+							Description: "The unit the metric is charged by",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit_name": {
+							Description: "The name of the charge unit",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit_display_name": {
+							Description: "The display name of the charge unit",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"charge_unit_quantity": {
+							Description: "The quantity of the charge unit the prices apply to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"resource_display_name": {
+							Description: "The display name of the resource the metric measures",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"usage_cap_qty": {
+							Description: "The usage limit of the metric",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"prices": {
+							Description: "The prices of the metric for each quantity tier",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"quantity_tier": {
+										Description: "The upper quantity of the tier",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"price": {
+										Description: "The price per charge unit quantity in the tier",
+										Type:        schema.TypeFloat,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMCatalogPricingRead(d *schema.ResourceData, meta interface{}) error {
+	serviceName := d.Get("service").(string)
+	plan := d.Get("plan").(string)
+	location := d.Get("location").(string)
+	country := d.Get("country").(string)
+
+	_, servicePlanID, err := getResourceCatalogServicePlan(meta, serviceName, plan)
+	if err != nil {
+		return err
+	}
+	deployment, err := getResourceCatalogDeployment(meta, servicePlanID, plan, location)
+	if err != nil {
+		return err
+	}
+	pricing, err := getResourceCatalogPricing(meta, servicePlanID, deployment.ID)
+	if err != nil {
+		return err
+	}
+
+	currency := ""
+	countries := map[string]bool{}
+	metrics := make([]interface{}, 0, len(pricing.Metrics))
+	for _, metric := range pricing.Metrics {
+		prices := []interface{}{}
+		for _, amount := range metric.Amounts {
+			countries[amount.Country] = true
+			if amount.Country != country {
+				continue
+			}
+			currency = amount.Currency
+			for _, price := range amount.Prices {
+				prices = append(prices, map[string]interface{}{
+					"quantity_tier": price.QuantityTier,
+					"price":         price.Price,
+				})
+			}
+		}
+		chargeUnitQuantity := ""
+		if metric.ChargeUnitQuantity != nil {
+			chargeUnitQuantity = fmt.Sprint(metric.ChargeUnitQuantity)
+		}
+		metrics = append(metrics, map[string]interface{}{
+			"metric_id":                metric.MetricID,
+			"part_ref":                 metric.PartRef,
+			"tier_model":               metric.TierModel,
+			"charge_unit":              metric.ChargeUnit,
+			"charge_unit_name":         metric.ChargeUnitName,
+			"charge_unit_display_name": metric.ChargeUnitDisplayName,
+			"charge_unit_quantity":     chargeUnitQuantity,
+			"resource_display_name":    metric.ResourceDisplayName,
+			"usage_cap_qty":            metric.UsageCapQty,
+			"prices":                   prices,
+		})
+	}
+	if len(countries) > 0 && !countries[country] {
+		countryList := make([]string, 0, len(countries))
+		for c := range countries {
+			countryList = append(countryList, c)
+		}
+		sort.Strings(countryList)
+		return fmt.Errorf("[ERROR] No pricing found for plan %s in country %s.\nValid country(s) are: %q", plan, country, countryList)
+	}
+
+	var startingPrice float64
+	for _, amount := range pricing.StartingPrice.Amount {
+		if amount.Country == country && len(amount.Prices) > 0 {
+			currency = amount.Currency
+			startingPrice = amount.Prices[0].Price
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", deployment.ID, country))
+	d.Set("plan_id", servicePlanID)
+	d.Set("deployment_id", deployment.ID)
+	d.Set("type", pricing.Type)
+	d.Set("origin", pricing.Origin)
+	d.Set("currency", currency)
+	d.Set("starting_price", startingPrice)
+	d.Set("starting_price_unit", pricing.StartingPrice.Unit)
+	d.Set("metrics", metrics)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCatalogPricingDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCatalogPricingDataSourceConfig("databases-for-postgresql", "standard", "us-south"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.pricing", "plan_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.pricing", "deployment_id"),
+					resource.TestCheckResourceAttr("data.ibm_catalog_pricing.pricing", "currency", "USD"),
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.pricing", "metrics.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_catalog_pricing.pricing", "metrics.0.prices.0.price"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCatalogPricingDataSource_invalid_location(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCatalogPricingDataSourceConfig("databases-for-postgresql", "standard", "invalid-location"),
+				ExpectError: regexp.MustCompile(`Valid location\(s\) are`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCatalogPricingDataSourceConfig(service, plan, location string) string {
+	return fmt.Sprintf(`
+data "ibm_catalog_pricing" "pricing" {
+    service  = "%s"
+    plan     = "%s"
+    location = "%s"
+}`, service, plan, location)
+}
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIBMResourceCatalogService() *schema.Resource {
+	return &schema.Resource{
+		Read: DataSourceIBMResourceCatalogServiceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the service offering like cloud-object-storage, kms etc",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"crn": {
+				Description: "The CRN of the service in the global catalog",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"kind": {
+				Description: "The kind of the service, e.g. service, iaas",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"active": {
+				Description: "Whether the service is active in the catalog",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"rc_provisionable": {
+				Description: "Whether instances of the service can be created by the resource controller",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"iam_compatible": {
+				Description: "Whether the service supports IAM",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"bindable": {
+				Description: "Whether the service supports bindings",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"plan_updateable": {
+				Description: "Whether the plan of an instance can be changed",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"service_key_supported": {
+				Description: "Whether the service supports resource keys",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+
+			"plans": {
+				Description: "The plans of the service",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the plan",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the plan",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"crn": {
+							Description: "The CRN of the plan in the global catalog",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"locations": {
+							Description: "The locations where instances of the plan can be created",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"parameters": {
+							Description: "The parameters the plan accepts at provisioning",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "The name of the parameter",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"type": {
+										Description: "The type of the parameter, e.g. text, boolean, number, enum",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"description": {
+										Description: "The description of the parameter",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"required": {
+										Description: "Whether the parameter is required",
+										Type:        schema.TypeBool,
+										Computed:    true,
+									},
+									"default_value": {
+										Description: "The default value of the parameter",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"options": {
+										Description: "The allowed values of the parameter",
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DataSourceIBMResourceCatalogServiceRead(d *schema.ResourceData, meta interface{}) error {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	name := d.Get("name").(string)
+	serviceOff, err := rsCatRepo.FindByName(name, true)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+	}
+	service := serviceOff[0]
+
+	servicePlans, err := rsCatRepo.GetServicePlans(service)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving plans of service %s: %s", name, err)
+	}
+
+	plans := make([]interface{}, 0, len(servicePlans))
+	for _, servicePlan := range servicePlans {
+		deployments, err := rsCatRepo.ListDeployments(servicePlan.ID)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", servicePlan.Name, err)
+		}
+		_, supportedLocations := FilterDeployments(deployments, "")
+		locations := make([]string, 0, len(supportedLocations))
+		for l := range supportedLocations {
+			locations = append(locations, l)
+		}
+		sort.Strings(locations)
+
+		catalogParams, err := getResourceCatalogParameters(meta, service.ID, servicePlan.ID)
+		if err != nil {
+			return err
+		}
+
+		plans = append(plans, map[string]interface{}{
+			"id":         servicePlan.ID,
+			"name":       servicePlan.Name,
+			"crn":        servicePlan.CatalogCRN,
+			"locations":  locations,
+			"parameters": flattenResourceCatalogParameters(catalogParams),
+		})
+	}
+
+	d.SetId(service.ID)
+	d.Set("crn", service.CatalogCRN)
+	d.Set("kind", service.Kind)
+	d.Set("active", service.Active)
+	if metadata, ok := service.Metadata.(*models.ServiceResourceMetadata); ok {
+		d.Set("rc_provisionable", metadata.Service.RCProvisionable)
+		d.Set("iam_compatible", metadata.Service.IAMCompatible)
+		d.Set("bindable", metadata.Service.Bindable)
+		d.Set("plan_updateable", metadata.Service.PlanUpdateable)
+		d.Set("service_key_supported", metadata.Service.ServiceKeySupported)
+	}
+	d.Set("plans", plans)
+
+	return nil
+}
+
+func flattenResourceCatalogParameters(catalogParams []resourceCatalogParameter) []interface{} {
+	out := make([]interface{}, 0, len(catalogParams))
+	for _, catalogParam := range catalogParams {
+		options := make([]string, 0, len(catalogParam.Options))
+		for _, option := range catalogParam.Options {
+			options = append(options, fmt.Sprint(option.Value))
+		}
+		defaultValue := ""
+		if catalogParam.Value != nil {
+			defaultValue = fmt.Sprint(catalogParam.Value)
+		}
+		out = append(out, map[string]interface{}{
+			"name":          catalogParam.Name,
+			"type":          catalogParam.Type,
+			"description":   catalogParam.Description,
+			"required":      catalogParam.Required,
+			"default_value": defaultValue,
+			"options":       options,
+		})
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceCatalogServiceDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceCatalogServiceDataSourceConfig("kms"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resource_catalog_service.service", "name", "kms"),
+					resource.TestCheckResourceAttr("data.ibm_resource_catalog_service.service", "rc_provisionable", "true"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_catalog_service.service", "plans.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_catalog_service.service", "plans.0.name"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_catalog_service.service", "plans.0.locations.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceCatalogServiceDataSource_invalid_name(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMResourceCatalogServiceDataSourceConfig("invalid-service"),
+				ExpectError: regexp.MustCompile(`Error retrieving service offering`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceCatalogServiceDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+data "ibm_resource_catalog_service" "service" {
+    name = "%s"
+}`, name)
+}
@@ -0,0 +1,263 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/models"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+// resourceCatalogParameter is a parameter accepted by a plan at provisioning,
+// as described in the global catalog
+type resourceCatalogParameter struct {
+	Name        string      `json:"name"`
+	Type        string      `json:"type"`
+	Description string      `json:"description"`
+	Required    bool        `json:"required"`
+	Value       interface{} `json:"value"`
+	Options     []struct {
+		Value interface{} `json:"value"`
+	} `json:"options"`
+}
+
+type resourceCatalogEntry struct {
+	Metadata struct {
+		Parameters []resourceCatalogParameter `json:"parameters"`
+	} `json:"metadata"`
+}
+
+// resourceCatalogAmount is the price of a metric in the currency of a country
+type resourceCatalogAmount struct {
+	Country  string `json:"country"`
+	Currency string `json:"currency"`
+	Prices   []struct {
+		QuantityTier int64   `json:"quantity_tier"`
+		Price        float64 `json:"price"`
+	} `json:"prices"`
+}
+
+// resourceCatalogPricing is the pricing of a plan or of one of its
+// deployments, as described in the global catalog
+type resourceCatalogPricing struct {
+	Type          string `json:"type"`
+	Origin        string `json:"origin"`
+	StartingPrice struct {
+		Unit   string                  `json:"unit"`
+		Amount []resourceCatalogAmount `json:"amount"`
+	} `json:"starting_price"`
+	Metrics []struct {
+		MetricID              string                  `json:"metric_id"`
+		PartRef               string                  `json:"part_ref"`
+		TierModel             string                  `json:"tier_model"`
+		ChargeUnit            string                  `json:"charge_unit"`
+		ChargeUnitName        string                  `json:"charge_unit_name"`
+		ChargeUnitDisplayName string                  `json:"charge_unit_display_name"`
+		ChargeUnitQuantity    interface{}             `json:"charge_unit_quantity"`
+		ResourceDisplayName   string                  `json:"resource_display_name"`
+		UsageCapQty           int64                   `json:"usage_cap_qty"`
+		Amounts               []resourceCatalogAmount `json:"amounts"`
+	} `json:"metrics"`
+}
+
+// resourceCatalogClient is implemented by the client behind the resource
+// catalog API, it reads the catalog entries that the repository doesn't
+// expose
+type resourceCatalogClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+// getResourceCatalogServicePlan returns the service offering and the ID of
+// its plan, the error lists the valid plans when the plan doesn't exist
+func getResourceCatalogServicePlan(meta interface{}, serviceName, plan string) (models.Service, string, error) {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return models.Service{}, "", err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
+	if err != nil {
+		return models.Service{}, "", fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
+	}
+
+	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
+		if !metadata.Service.RCProvisionable {
+			return models.Service{}, "", fmt.Errorf("%s cannot be provisioned by resource controller", serviceName)
+		}
+	} else {
+		return models.Service{}, "", fmt.Errorf("[ERROR] Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName)
+	}
+
+	servicePlans, err := rsCatRepo.GetServicePlans(serviceOff[0])
+	if err != nil {
+		return models.Service{}, "", fmt.Errorf("[ERROR] Error retrieving plans of service %s: %s", serviceName, err)
+	}
+	planList := make([]string, 0, len(servicePlans))
+	for _, servicePlan := range servicePlans {
+		if servicePlan.Name == plan {
+			return serviceOff[0], servicePlan.ID, nil
+		}
+		planList = append(planList, servicePlan.Name)
+	}
+	sort.Strings(planList)
+	return models.Service{}, "", fmt.Errorf("[ERROR] No plan %s found for service %s.\nValid plan(s) are: %q", plan, serviceName, planList)
+}
+
+// getResourceCatalogDeployment returns the deployment of the plan at the
+// location, the error lists the valid locations when there's none
+func getResourceCatalogDeployment(meta interface{}, servicePlanID, plan, location string) (models.ServiceDeployment, error) {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return models.ServiceDeployment{}, err
+	}
+
+	deployments, err := rsCatClient.ResourceCatalog().ListDeployments(servicePlanID)
+	if err != nil {
+		return models.ServiceDeployment{}, fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
+	}
+	if len(deployments) == 0 {
+		return models.ServiceDeployment{}, fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
+	}
+	deployments, supportedLocations := FilterDeployments(deployments, location)
+
+	if len(deployments) == 0 {
+		locationList := make([]string, 0, len(supportedLocations))
+		for l := range supportedLocations {
+			locationList = append(locationList, l)
+		}
+		sort.Strings(locationList)
+		return models.ServiceDeployment{}, fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service", plan, location, locationList)
+	}
+	return deployments[0], nil
+}
+
+// getResourceCatalogParameters returns the parameters the plan accepts at
+// provisioning, the ones of the service when the plan doesn't describe them
+func getResourceCatalogParameters(meta interface{}, serviceID, servicePlanID string) ([]resourceCatalogParameter, error) {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return nil, err
+	}
+	client, ok := rsCatClient.(resourceCatalogClient)
+	if !ok {
+		return nil, fmt.Errorf("[ERROR] The resource catalog client doesn't support reading catalog entries")
+	}
+
+	for _, id := range []string{servicePlanID, serviceID} {
+		entry := resourceCatalogEntry{}
+		if _, err := client.Get(fmt.Sprintf("/api/v1/%s?include=metadata.parameters", id), &entry); err != nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving catalog entry %s: %s", id, err)
+		}
+		if len(entry.Metadata.Parameters) > 0 {
+			return entry.Metadata.Parameters, nil
+		}
+	}
+	return nil, nil
+}
+
+// getResourceCatalogPricing returns the pricing of the deployment, or the
+// pricing of the plan when the deployment isn't priced on its own
+func getResourceCatalogPricing(meta interface{}, servicePlanID, deploymentID string) (resourceCatalogPricing, error) {
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return resourceCatalogPricing{}, err
+	}
+	client, ok := rsCatClient.(resourceCatalogClient)
+	if !ok {
+		return resourceCatalogPricing{}, fmt.Errorf("[ERROR] The resource catalog client doesn't support reading catalog entries")
+	}
+
+	for _, id := range []string{deploymentID, servicePlanID} {
+		pricing := resourceCatalogPricing{}
+		resp, err := client.Get(fmt.Sprintf("/api/v1/%s/pricing", id), &pricing)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound && id != servicePlanID {
+				continue
+			}
+			return resourceCatalogPricing{}, fmt.Errorf("[ERROR] Error retrieving pricing of catalog entry %s: %s", id, err)
+		}
+		return pricing, nil
+	}
+	return resourceCatalogPricing{}, nil
+}
+
+// validateResourceCatalogParameters checks the parameters against the ones
+// described in the catalog: booleans, numbers and the parameters with options
+// must have a valid value. A missing required parameter is only logged, the
+// service broker may not need it, and unknown parameters are left to the
+// service broker.
+func validateResourceCatalogParameters(params map[string]interface{}, catalogParams []resourceCatalogParameter) error {
+	errs := []string{}
+	for _, catalogParam := range catalogParams {
+		value, ok := params[catalogParam.Name]
+		if !ok {
+			if catalogParam.Required && catalogParam.Value == nil {
+				log.Printf("[WARN] Parameter %q is required by the service plan in the catalog but not set", catalogParam.Name)
+			}
+			continue
+		}
+
+		switch catalogParam.Type {
+		case "boolean", "checkbox":
+			if _, ok := value.(bool); !ok {
+				errs = append(errs, fmt.Sprintf("parameter %q must be a boolean, got %v", catalogParam.Name, value))
+				continue
+			}
+		case "number", "integer":
+			if !isResourceCatalogNumber(value) {
+				errs = append(errs, fmt.Sprintf("parameter %q must be a number, got %v", catalogParam.Name, value))
+				continue
+			}
+		}
+
+		if len(catalogParam.Options) > 0 {
+			allowed := make([]string, 0, len(catalogParam.Options))
+			for _, option := range catalogParam.Options {
+				allowed = append(allowed, fmt.Sprint(option.Value))
+			}
+			values := []string{fmt.Sprint(value)}
+			if list, ok := value.([]string); ok {
+				values = list
+			}
+			for _, v := range values {
+				if !resourceCatalogContains(allowed, v) {
+					errs = append(errs, fmt.Sprintf("parameter %q must be one of %q, got %q", catalogParam.Name, allowed, v))
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("[ERROR] Invalid parameters for the service plan:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func isResourceCatalogNumber(value interface{}) bool {
+	switch v := value.(type) {
+	case float64, int, int64:
+		return true
+	case string:
+		_, err := strconv.ParseFloat(v, 64)
+		return err == nil
+	}
+	return false
+}
+
+func resourceCatalogContains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,277 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func ResourceIBMResourceAlias() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMResourceAliasCreate,
+		Read:     resourceIBMResourceAliasRead,
+		Update:   resourceIBMResourceAliasUpdate,
+		Delete:   resourceIBMResourceAliasDelete,
+		Exists:   resourceIBMResourceAliasExists,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the alias",
+			},
+
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or CRN of the resource instance being aliased",
+			},
+
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the target namespace in the specific environment, such as a Cloud Foundry space",
+			},
+
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When you create a new alias, a globally unique identifier (GUID) is assigned.",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full Cloud Resource Name (CRN) associated with the alias.",
+			},
+
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When you created a new alias, a relative URL path is created identifying the location of the alias.",
+			},
+
+			"target_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the target namespace in the specific environment.",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An alpha-numeric value identifying the account ID.",
+			},
+
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique ID of the offering.",
+			},
+
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the resource group.",
+			},
+
+			"region_instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the instance in the specific target environment, for example, service_instance_id in a given IBM Cloud environment.",
+			},
+
+			"region_instance_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the instance in the specific target environment.",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the alias.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the alias was created.",
+			},
+
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject who created the alias.",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceAliasCreate := rsContClient.NewCreateResourceAliasOptions(d.Get("name").(string), d.Get("resource_instance_id").(string), d.Get("target").(string))
+	resourceAlias, resp, err := rsContClient.CreateResourceAlias(resourceAliasCreate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating resource alias: %s with resp code: %s", err, resp)
+	}
+
+	d.SetId(*resourceAlias.ID)
+
+	_, err = waitForResourceAliasState(d, meta, []string{RsInstanceProgressStatus, RsInstanceProvisioningStatus, RsInstanceInactiveStatus}, RsInstanceSuccessStatus, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for create resource alias (%s) to be succeeded: %s", d.Id(), err)
+	}
+
+	return resourceIBMResourceAliasRead(d, meta)
+}
+
+func resourceIBMResourceAliasRead(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceAlias, resp, err := rsContClient.GetResourceAlias(rsContClient.NewGetResourceAliasOptions(d.Id()))
+	if err != nil || resourceAlias == nil {
+		return fmt.Errorf("[ERROR] Error retrieving resource alias: %s with resp : %s", err, resp)
+	}
+
+	d.Set("name", resourceAlias.Name)
+	if _, ok := d.GetOk("resource_instance_id"); !ok {
+		d.Set("resource_instance_id", resourceAlias.ResourceInstanceID)
+	}
+	if _, ok := d.GetOk("target"); !ok {
+		d.Set("target", resourceAlias.TargetCRN)
+	}
+	d.Set("guid", resourceAlias.GUID)
+	d.Set("crn", resourceAlias.CRN)
+	d.Set("url", resourceAlias.URL)
+	d.Set("target_crn", resourceAlias.TargetCRN)
+	d.Set("account_id", resourceAlias.AccountID)
+	d.Set("resource_id", resourceAlias.ResourceID)
+	d.Set("resource_group_id", resourceAlias.ResourceGroupID)
+	d.Set("region_instance_id", resourceAlias.RegionInstanceID)
+	d.Set("region_instance_crn", resourceAlias.RegionInstanceCRN)
+	d.Set("state", resourceAlias.State)
+	if resourceAlias.CreatedAt != nil {
+		d.Set("created_at", resourceAlias.CreatedAt.String())
+	} else {
+		d.Set("created_at", "")
+	}
+	d.Set("created_by", resourceAlias.CreatedBy)
+
+	return nil
+}
+
+func resourceIBMResourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") {
+		resourceAliasUpdate := rsContClient.NewUpdateResourceAliasOptions(d.Id(), d.Get("name").(string))
+		_, resp, err := rsContClient.UpdateResourceAlias(resourceAliasUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating resource alias: %s with resp code: %s", err, resp)
+		}
+	}
+
+	return resourceIBMResourceAliasRead(d, meta)
+}
+
+func resourceIBMResourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resp, err := rsContClient.DeleteResourceAlias(rsContClient.NewDeleteResourceAliasOptions(d.Id()))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 410 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting resource alias: %s with resp code: %s", err, resp)
+	}
+
+	_, err = waitForResourceAliasState(d, meta, []string{RsInstanceSuccessStatus, RsInstanceProgressStatus, RsInstanceInactiveStatus}, RsInstanceRemovedStatus, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for resource alias (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMResourceAliasExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+
+	resourceAlias, resp, err := rsContClient.GetResourceAlias(rsContClient.NewGetResourceAliasOptions(d.Id()))
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return false, nil
+		}
+		return false, fmt.Errorf("[ERROR] Error getting resource alias: %s with resp code: %s", err, resp)
+	}
+	if resourceAlias.State != nil && *resourceAlias.State == RsInstanceRemovedStatus {
+		return false, nil
+	}
+
+	return *resourceAlias.ID == d.Id(), nil
+}
+
+func waitForResourceAliasState(d *schema.ResourceData, meta interface{}, pending []string, target string, timeout time.Duration) (interface{}, error) {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	resourceAliasGet := rsContClient.NewGetResourceAliasOptions(d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resourceAlias, resp, err := rsContClient.GetResourceAlias(resourceAliasGet)
+			if err != nil {
+				if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) && target == RsInstanceRemovedStatus {
+					return &rc.ResourceAlias{}, RsInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get the resource alias %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+			}
+			if *resourceAlias.State == RsInstanceFailStatus {
+				return resourceAlias, *resourceAlias.State, fmt.Errorf("[ERROR] The resource alias %s failed", d.Id())
+			}
+			return resourceAlias, *resourceAlias.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMResourceAlias_Basic(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cloudant-%d", acctest.RandIntRange(10, 100))
+	aliasName := fmt.Sprintf("tf-alias-%d", acctest.RandIntRange(10, 100))
+	updatedAliasName := fmt.Sprintf("tf-alias-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceAliasBasic(resourceName, aliasName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceAliasExists("ibm_resource_alias.alias"),
+					resource.TestCheckResourceAttr("ibm_resource_alias.alias", "name", aliasName),
+					resource.TestCheckResourceAttr("ibm_resource_alias.alias", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_resource_alias.alias", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceAliasBasic(resourceName, updatedAliasName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceAliasExists("ibm_resource_alias.alias"),
+					resource.TestCheckResourceAttr("ibm_resource_alias.alias", "name", updatedAliasName),
+				),
+			},
+			{
+				ResourceName:      "ibm_resource_alias.alias",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_instance_id", "target"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceAliasExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := rsContClient.GetResourceAlias(rsContClient.NewGetResourceAliasOptions(rs.Primary.ID))
+		if err != nil {
+			return fmt.Errorf("Get resource alias error: %s with resp code: %s", err, resp)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceAliasDestroy(s *terraform.State) error {
+	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_resource_alias" {
+			continue
+		}
+
+		alias, resp, err := rsContClient.GetResourceAlias(rsContClient.NewGetResourceAliasOptions(rs.Primary.ID))
+		if err == nil {
+			if *alias.State == "removed" {
+				return nil
+			}
+			return fmt.Errorf("Resource alias still exists: %s with resp code: %s", rs.Primary.ID, resp)
+		} else if resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410) {
+			return fmt.Errorf("[ERROR] Error waiting for resource alias (%s) to be destroyed: %s with resp code: %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMResourceAliasBasic(resourceName, aliasName string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_org" "org" {
+			org = "%s"
+		}
+
+		data "ibm_space" "space" {
+			org   = "%s"
+			space = "%s"
+		}
+
+		resource "ibm_resource_instance" "resource" {
+			name     = "%s"
+			service  = "cloudantnosqldb"
+			plan     = "lite"
+			location = "us-south"
+		}
+
+		resource "ibm_resource_alias" "alias" {
+			name                 = "%s"
+			resource_instance_id = ibm_resource_instance.resource.guid
+			target               = "crn:v1:bluemix:public:cf:us-south:o/${data.ibm_org.org.id}::cf-space:${data.ibm_space.space.id}"
+		}
+	`, acc.CfOrganization, acc.CfOrganization, acc.CfSpace, resourceName, aliasName)
+}
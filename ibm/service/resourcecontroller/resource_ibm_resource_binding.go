@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMResourceBinding() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMResourceBindingCreate,
+		Read:     resourceIBMResourceBindingRead,
+		Update:   resourceIBMResourceBindingUpdate,
+		Delete:   resourceIBMResourceBindingDelete,
+		Exists:   resourceIBMResourceBindingExists,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the binding",
+			},
+
+			"resource_alias_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the resource alias being bound",
+			},
+
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the application to bind to in a specific environment, such as a Cloud Foundry application or a Kubernetes cluster",
+			},
+
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the user role. Valid roles are Writer, Reader, Manager, Administrator, Operator, Viewer, Editor and Custom Roles.",
+			},
+
+			"serviceid_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The CRN of an existing service ID to use for the credentials of the binding",
+			},
+
+			"credentials": {
+				Description: "Credentials asociated with the binding",
+				Type:        schema.TypeMap,
+				Sensitive:   true,
+				Computed:    true,
+			},
+
+			"credentials_json": {
+				Description: "Credentials asociated with the binding in json string",
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+			},
+
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When you create a new binding, a globally unique identifier (GUID) is assigned.",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The full Cloud Resource Name (CRN) associated with the binding.",
+			},
+
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When you created a new binding, a relative URL path is created identifying the location of the binding.",
+			},
+
+			"source_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of resource alias associated to the binding.",
+			},
+
+			"target_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of target resource, for example, application, in a specific environment.",
+			},
+
+			"region_binding_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the binding in the specific target environment, for example, service_binding_id in a given IBM Cloud environment.",
+			},
+
+			"region_binding_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the binding in the specific target environment.",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An alpha-numeric value identifying the account ID.",
+			},
+
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the resource group.",
+			},
+
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique ID of the offering.",
+			},
+
+			"iam_compatible": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Specifies whether the binding's credentials support IAM.",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the binding.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the binding was created.",
+			},
+
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject who created the binding.",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceBindingCreate := rsContClient.NewCreateResourceBindingOptions(d.Get("resource_alias_id").(string), d.Get("target").(string))
+	if name, ok := d.GetOk("name"); ok {
+		resourceBindingCreate.SetName(name.(string))
+	}
+	if serviceIDCRN, ok := d.GetOk("serviceid_crn"); ok {
+		resourceBindingCreate.SetParameters(&rc.ResourceBindingPostParameters{
+			ServiceidCRN: flex.PtrToString(serviceIDCRN.(string)),
+		})
+	}
+	if r, ok := d.GetOk("role"); ok {
+		// The role is looked up in the service of the aliased instance
+		resourceInstance, _, err := getResourceInstanceAndCRN(d, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating resource binding when get instance and CRN: %s", err)
+		}
+		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating resource binding when get ResourceCatalogAPI: %s", err)
+		}
+		service, err := rsCatClient.ResourceCatalog().Get(*resourceInstance.ResourceID, true)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating resource binding when get service: %s", err)
+		}
+		serviceRole, err := getRoleFromName(r.(string), service.Name, meta)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error creating resource binding when get role: %s", err)
+		}
+		resourceBindingCreate.Role = serviceRole.RoleID
+	}
+
+	resourceBinding, resp, err := rsContClient.CreateResourceBinding(resourceBindingCreate)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating resource binding: %s with resp code: %s", err, resp)
+	}
+
+	d.SetId(*resourceBinding.ID)
+
+	_, err = waitForResourceBindingState(d, meta, []string{RsInstanceProgressStatus, RsInstanceProvisioningStatus, RsInstanceInactiveStatus}, RsInstanceSuccessStatus, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for create resource binding (%s) to be succeeded: %s", d.Id(), err)
+	}
+
+	return resourceIBMResourceBindingRead(d, meta)
+}
+
+func resourceIBMResourceBindingRead(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceBinding, resp, err := rsContClient.GetResourceBinding(rsContClient.NewGetResourceBindingOptions(d.Id()))
+	if err != nil || resourceBinding == nil {
+		return fmt.Errorf("[ERROR] Error retrieving resource binding: %s with resp : %s", err, resp)
+	}
+
+	var credInterface map[string]interface{}
+	cred, _ := json.Marshal(resourceBinding.Credentials)
+	json.Unmarshal(cred, &credInterface)
+	d.Set("credentials", flex.Flatten(credInterface))
+
+	creds, err := json.Marshal(resourceBinding.Credentials)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error marshalling resource binding credentials: %s", err)
+	}
+	if err = d.Set("credentials_json", string(creds)); err != nil {
+		return fmt.Errorf("[ERROR] Error setting the credentials json: %s", err)
+	}
+
+	d.Set("name", resourceBinding.Name)
+	if _, ok := d.GetOk("target"); !ok {
+		d.Set("target", resourceBinding.TargetCRN)
+	}
+	d.Set("guid", resourceBinding.GUID)
+	d.Set("crn", resourceBinding.CRN)
+	d.Set("url", resourceBinding.URL)
+	d.Set("source_crn", resourceBinding.SourceCRN)
+	d.Set("target_crn", resourceBinding.TargetCRN)
+	d.Set("region_binding_id", resourceBinding.RegionBindingID)
+	d.Set("region_binding_crn", resourceBinding.RegionBindingCRN)
+	d.Set("account_id", resourceBinding.AccountID)
+	d.Set("resource_group_id", resourceBinding.ResourceGroupID)
+	d.Set("resource_id", resourceBinding.ResourceID)
+	d.Set("iam_compatible", resourceBinding.IamCompatible)
+	d.Set("state", resourceBinding.State)
+	if resourceBinding.CreatedAt != nil {
+		d.Set("created_at", resourceBinding.CreatedAt.String())
+	} else {
+		d.Set("created_at", "")
+	}
+	d.Set("created_by", resourceBinding.CreatedBy)
+
+	return nil
+}
+
+func resourceIBMResourceBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") {
+		resourceBindingUpdate := rsContClient.NewUpdateResourceBindingOptions(d.Id(), d.Get("name").(string))
+		_, resp, err := rsContClient.UpdateResourceBinding(resourceBindingUpdate)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating resource binding: %s with resp code: %s", err, resp)
+		}
+	}
+
+	return resourceIBMResourceBindingRead(d, meta)
+}
+
+func resourceIBMResourceBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resp, err := rsContClient.DeleteResourceBinding(rsContClient.NewDeleteResourceBindingOptions(d.Id()))
+	if err != nil {
+		if resp != nil && resp.StatusCode == 410 {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting resource binding: %s with resp code: %s", err, resp)
+	}
+
+	_, err = waitForResourceBindingState(d, meta, []string{RsInstanceSuccessStatus, RsInstanceProgressStatus, RsInstanceInactiveStatus}, RsInstanceRemovedStatus, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for resource binding (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMResourceBindingExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+
+	resourceBinding, resp, err := rsContClient.GetResourceBinding(rsContClient.NewGetResourceBindingOptions(d.Id()))
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return false, nil
+		}
+		return false, fmt.Errorf("[ERROR] Error getting resource binding: %s with resp code: %s", err, resp)
+	}
+	if resourceBinding.State != nil && *resourceBinding.State == RsInstanceRemovedStatus {
+		return false, nil
+	}
+
+	return *resourceBinding.ID == d.Id(), nil
+}
+
+func waitForResourceBindingState(d *schema.ResourceData, meta interface{}, pending []string, target string, timeout time.Duration) (interface{}, error) {
+	rsContClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	resourceBindingGet := rsContClient.NewGetResourceBindingOptions(d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending: pending,
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			resourceBinding, resp, err := rsContClient.GetResourceBinding(resourceBindingGet)
+			if err != nil {
+				if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) && target == RsInstanceRemovedStatus {
+					return &rc.ResourceBinding{}, RsInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get the resource binding %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+			}
+			if *resourceBinding.State == RsInstanceFailStatus {
+				return resourceBinding, *resourceBinding.State, fmt.Errorf("[ERROR] The resource binding %s failed", d.Id())
+			}
+			return resourceBinding, *resourceBinding.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
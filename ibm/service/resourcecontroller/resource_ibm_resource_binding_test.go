@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceBinding_Basic(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cloudant-%d", acctest.RandIntRange(10, 100))
+	aliasName := fmt.Sprintf("tf-alias-%d", acctest.RandIntRange(10, 100))
+	appName := fmt.Sprintf("tf-app-%d", acctest.RandIntRange(10, 100))
+	bindingName := fmt.Sprintf("tf-binding-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceBindingBasic(resourceName, aliasName, appName, bindingName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_binding.binding", "name", bindingName),
+					resource.TestCheckResourceAttr("ibm_resource_binding.binding", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_resource_binding.binding", "credentials_json"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceBindingBasic(resourceName, aliasName, appName, bindingName string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_org" "org" {
+			org = "%s"
+		}
+
+		data "ibm_space" "space" {
+			org   = "%s"
+			space = "%s"
+		}
+
+		resource "ibm_resource_instance" "resource" {
+			name     = "%s"
+			service  = "cloudantnosqldb"
+			plan     = "lite"
+			location = "us-south"
+		}
+
+		resource "ibm_resource_alias" "alias" {
+			name                 = "%s"
+			resource_instance_id = ibm_resource_instance.resource.guid
+			target               = "crn:v1:bluemix:public:cf:us-south:o/${data.ibm_org.org.id}::cf-space:${data.ibm_space.space.id}"
+		}
+
+		resource "ibm_app" "app" {
+			name                 = "%s"
+			space_guid           = data.ibm_space.space.id
+			app_path             = "../../test-fixtures/app1.zip"
+			wait_timeout_minutes = 20
+			buildpack            = "sdk-for-nodejs"
+			instances            = 1
+			command              = "npm install && node app.js"
+		}
+
+		resource "ibm_resource_binding" "binding" {
+			name              = "%s"
+			resource_alias_id = ibm_resource_alias.alias.id
+			target            = "crn:v1:bluemix:public:cf:us-south:s/${data.ibm_space.space.id}::cf-application:${ibm_app.app.id}"
+			role              = "Writer"
+		}
+	`, acc.CfOrganization, acc.CfOrganization, acc.CfSpace, resourceName, aliasName, appName, bindingName)
+}
@@ -53,6 +53,9 @@ func ResourceIBMResourceInstance() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.ResourceTagsCustomizeDiff(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMResourceInstanceCatalogCustomizeDiff(diff, v)
+			},
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -136,6 +139,19 @@ func ResourceIBMResourceInstance() *schema.Resource {
 				Description: "Guid of resource instance",
 			},
 
+			"reclaim_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_resource_instance", "reclaim_policy"),
+				Description:  "How an instance pending reclamation with the same name is handled on create, and how the instance is deleted. Possible values are 'purge' and 'restore_if_exists'.",
+			},
+
+			"wait_for_state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The state to wait for after create and update, in the state of the instance or of its last operation reported by the service broker, e.g. 'active' or 'succeeded'.",
+			},
+
 			"service_endpoints": {
 				Description:  "Types of the service endpoints. Possible values are 'public', 'private', 'public-and-private'.",
 				Type:         schema.TypeString,
@@ -360,7 +376,13 @@ func ResourceIBMResourceInstanceValidator() *validate.ResourceValidator {
 			Optional:                   true,
 			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
 			MinValueLength:             1,
-			MaxValueLength:             128})
+			MaxValueLength:             128},
+		validate.ValidateSchema{
+			Identifier:                 "reclaim_policy",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              RsInstanceReclaimPolicyPurge + ", " + RsInstanceReclaimPolicyRestoreIfExists})
 
 	ibmResourceInstanceResourceValidator := validate.ResourceValidator{ResourceName: "ibm_resource_instance", Schema: validateSchema}
 	return &ibmResourceInstanceResourceValidator
@@ -381,49 +403,18 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 		Name: &name,
 	}
 
-	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	serviceOff, servicePlan, err := getResourceCatalogServicePlan(meta, serviceName, plan)
 	if err != nil {
 		return err
 	}
-	rsCatRepo := rsCatClient.ResourceCatalog()
-
-	serviceOff, err := rsCatRepo.FindByName(serviceName, true)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
-	}
-
-	if metadata, ok := serviceOff[0].Metadata.(*models.ServiceResourceMetadata); ok {
-		if !metadata.Service.RCProvisionable {
-			return fmt.Errorf("%s cannot be provisioned by resource controller", serviceName)
-		}
-	} else {
-		return fmt.Errorf("[ERROR] Cannot create instance of resource %s\nUse 'ibm_service_instance' if the resource is a Cloud Foundry service", serviceName)
-	}
-
-	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
-	}
 	rsInst.ResourcePlanID = &servicePlan
 
-	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	deployment, err := getResourceCatalogDeployment(meta, servicePlan, plan, location)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving deployment for plan %s : %s", plan, err)
-	}
-	if len(deployments) == 0 {
-		return fmt.Errorf("[ERROR] No deployment found for service plan : %s", plan)
-	}
-	deployments, supportedLocations := FilterDeployments(deployments, location)
-
-	if len(deployments) == 0 {
-		locationList := make([]string, 0, len(supportedLocations))
-		for l := range supportedLocations {
-			locationList = append(locationList, l)
-		}
-		return fmt.Errorf("[ERROR] No deployment found for service plan %s at location %s.\nValid location(s) are: %q.\nUse 'ibm_service_instance' if the service is a Cloud Foundry service", plan, location, locationList)
+		return err
 	}
 
-	rsInst.Target = &deployments[0].CatalogCRN
+	rsInst.Target = &deployment.CatalogCRN
 
 	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
 		rg := rsGrpID.(string)
@@ -443,31 +434,7 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if parameters, ok := d.GetOk("parameters"); ok {
-		temp := parameters.(map[string]interface{})
-		for k, v := range temp {
-			if v == "true" || v == "false" {
-				b, _ := strconv.ParseBool(v.(string))
-				params[k] = b
-			} else if strings.HasPrefix(v.(string), "[") && strings.HasSuffix(v.(string), "]") {
-				//transform v.(string) to be []string
-				arrayString := v.(string)
-				result := []string{}
-				trimLeft := strings.TrimLeft(arrayString, "[")
-				trimRight := strings.TrimRight(trimLeft, "]")
-				if len(trimRight) == 0 {
-					params[k] = result
-				} else {
-					array := strings.Split(trimRight, ",")
-					for _, a := range array {
-						result = append(result, strings.Trim(a, "\""))
-					}
-					params[k] = result
-				}
-			} else {
-				params[k] = v
-			}
-		}
-
+		expandResourceInstanceParameters(parameters.(map[string]interface{}), params)
 	}
 	if s, ok := d.GetOk("parameters_json"); ok {
 		json.Unmarshal([]byte(s.(string)), &params)
@@ -475,6 +442,40 @@ func ResourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsInst.Parameters = params
 
+	// An instance pending reclamation with the same name blocks the creation,
+	// it is purged or restored as the reclaim policy says
+	if reclaimPolicy, ok := d.GetOk("reclaim_policy"); ok {
+		reclamation, err := findReclaimedResourceInstance(meta, name, *rsInst.ResourceGroup, serviceOff.ID)
+		if err != nil {
+			return err
+		}
+		if reclamation != nil && reclaimPolicy.(string) == RsInstanceReclaimPolicyRestoreIfExists {
+			log.Printf("[INFO] Restoring resource instance %s pending reclamation", *reclamation.ResourceInstanceID)
+			if err = runResourceInstanceReclamationAction(meta, reclamation, rsReclamationActionRestore, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+			d.SetId(*reclamation.ResourceInstanceID)
+			instance, resp, err := rsConClient.GetResourceInstance(rsConClient.NewGetResourceInstanceOptions(d.Id()))
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving restored resource instance: %s with resp code: %s", err, resp)
+			}
+			if _, ok := d.GetOk("tags"); ok {
+				oldList, newList := d.GetChange("tags")
+				if err = flex.UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN); err != nil {
+					log.Printf(
+						"Error on create of resource instance (%s) tags: %s", d.Id(), err)
+				}
+			}
+			return ResourceIBMResourceInstanceRead(d, meta)
+		}
+		if reclamation != nil {
+			log.Printf("[INFO] Purging resource instance %s pending reclamation", *reclamation.ResourceInstanceID)
+			if err = runResourceInstanceReclamationAction(meta, reclamation, rsReclamationActionReclaim, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return err
+			}
+		}
+	}
+
 	//Start to create resource instance
 	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
 	if err != nil {
@@ -640,21 +641,10 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 	if d.HasChange("plan") {
 		plan := d.Get("plan").(string)
 		service := d.Get("service").(string)
-		rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+		_, servicePlan, err := getResourceCatalogServicePlan(meta, service, plan)
 		if err != nil {
 			return err
 		}
-		rsCatRepo := rsCatClient.ResourceCatalog()
-
-		serviceOff, err := rsCatRepo.FindByName(service, true)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving service offering: %s", err)
-		}
-
-		servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
-		if err != nil {
-			return fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
-		}
 
 		resourceInstanceUpdate.ResourcePlanID = &servicePlan
 
@@ -676,31 +666,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if parameters, ok := d.GetOk("parameters"); ok {
-			temp := parameters.(map[string]interface{})
-			for k, v := range temp {
-				if v == "true" || v == "false" {
-					b, _ := strconv.ParseBool(v.(string))
-					params[k] = b
-				} else if strings.HasPrefix(v.(string), "[") && strings.HasSuffix(v.(string), "]") {
-					//transform v.(string) to be []string
-					result := []string{}
-					arrayString := v.(string)
-					trimLeft := strings.TrimLeft(arrayString, "[")
-					trimRight := strings.TrimRight(trimLeft, "]")
-					if len(trimRight) == 0 {
-						params[k] = result
-					} else {
-						array := strings.Split(trimRight, ",")
-						for _, a := range array {
-							result = append(result, strings.Trim(a, "\""))
-						}
-						params[k] = result
-					}
-
-				} else {
-					params[k] = v
-				}
-			}
+			expandResourceInstanceParameters(parameters.(map[string]interface{}), params)
 		}
 		serviceEndpoints := d.Get("service_endpoints").(string)
 		if serviceEndpoints != "" {
@@ -740,7 +706,7 @@ func ResourceIBMResourceInstanceUpdate(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error updating resource instance: %s with resp code: %s", err, resp)
 	}
 
-	_, err = waitForResourceInstanceUpdate(d, meta)
+	_, err = waitForResourceInstanceUpdate(d, meta, instance.LastOperation)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
 	}
@@ -773,6 +739,18 @@ func ResourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("[ERROR] Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
 	}
 
+	if d.Get("reclaim_policy").(string) == RsInstanceReclaimPolicyPurge {
+		reclamation, err := getResourceInstanceReclamation(meta, id)
+		if err != nil {
+			return err
+		}
+		if reclamation != nil {
+			if err = runResourceInstanceReclamationAction(meta, reclamation, rsReclamationActionReclaim, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return fmt.Errorf("[ERROR] Error purging resource instance (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -804,40 +782,35 @@ func ResourceIBMResourceInstanceExists(d *schema.ResourceData, meta interface{})
 }
 
 func waitForResourceInstanceCreate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
-	if err != nil {
-		return false, err
-	}
-	instanceID := d.Id()
-	resourceInstanceGet := rc.GetResourceInstanceOptions{
-		ID: &instanceID,
-	}
+	return waitForResourceInstanceOperation(d, meta, "create", nil,
+		[]string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
+		[]string{RsInstanceSuccessStatus}, d.Get("wait_for_state").(string), d.Timeout(schema.TimeoutCreate))
+}
 
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
-		Target:  []string{RsInstanceSuccessStatus},
-		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
-			if err != nil {
-				if resp != nil && resp.StatusCode == 404 {
-					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v", d.Id(), err)
-				}
-				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
-			}
-			if *instance.State == RsInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v", d.Id(), err)
-			}
-			return instance, *instance.State, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
-	}
+// waitForResourceInstanceUpdate waits for the update requested after
+// previous, the last operation of the instance before the update
+func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}, previous map[string]interface{}) (interface{}, error) {
+	return waitForResourceInstanceOperation(d, meta, "update", previous,
+		[]string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceProvisioningStatus},
+		[]string{RsInstanceSuccessStatus}, d.Get("wait_for_state").(string), d.Timeout(schema.TimeoutUpdate))
+}
 
-	return stateConf.WaitForState()
+func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	previous, _ := d.Get("last_operation").(map[string]interface{})
+	return waitForResourceInstanceOperation(d, meta, "delete", previous,
+		[]string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceSuccessStatus},
+		[]string{RsInstanceRemovedStatus, RsInstanceReclamation}, "", d.Timeout(schema.TimeoutDelete))
 }
 
-func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+// waitForResourceInstanceOperation polls the instance until it reaches one of
+// the target states and the broker doesn't report its last operation in
+// progress anymore. With waitForState, it polls until the instance or its
+// last operation reaches that state instead. A failed instance or last
+// operation stops the wait with the reason reported by the broker. The last
+// operation is only looked at once it is the operation of type operationType
+// that followed previous, the last operation before the wait; until then its
+// state belongs to an older operation and is ignored.
+func waitForResourceInstanceOperation(d *schema.ResourceData, meta interface{}, operationType string, previous map[string]interface{}, pending, target []string, waitForState string, timeout time.Duration) (interface{}, error) {
 	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
 		return false, err
@@ -846,24 +819,46 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 	resourceInstanceGet := rc.GetResourceInstanceOptions{
 		ID: &instanceID,
 	}
+	removal := target[0] == RsInstanceRemovedStatus
+	if waitForState != "" {
+		pending = []string{RsInstanceProgressStatus}
+		target = []string{waitForState}
+	}
 
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus},
-		Target:  []string{RsInstanceSuccessStatus},
+		Pending: pending,
+		Target:  target,
 		Refresh: func() (interface{}, string, error) {
 			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
 			if err != nil {
-				if resp != nil && resp.StatusCode == 404 {
+				if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+					if removal {
+						return &rc.ResourceInstance{}, RsInstanceRemovedStatus, nil
+					}
 					return nil, "", fmt.Errorf("[ERROR] The resource instance %s does not exist anymore: %v", d.Id(), err)
 				}
 				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
 			}
-			if *instance.State == RsInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed: %v", d.Id(), err)
+
+			operationState := ""
+			if resourceInstanceOperationCurrent(instance.LastOperation, previous, operationType) {
+				operationState, _ = instance.LastOperation["state"].(string)
+			}
+			if *instance.State == RsInstanceFailStatus || operationState == RsInstanceFailStatus {
+				return instance, RsInstanceFailStatus, fmt.Errorf("[ERROR] The resource instance %s failed: %s", d.Id(), resourceInstanceOperationFailure(instance.LastOperation))
+			}
+			if waitForState != "" {
+				if *instance.State == waitForState || operationState == waitForState {
+					return instance, waitForState, nil
+				}
+				return instance, RsInstanceProgressStatus, nil
+			}
+			if operationState == RsInstanceProgressStatus {
+				return instance, RsInstanceProgressStatus, nil
 			}
 			return instance, *instance.State, nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Timeout:    timeout,
 		Delay:      10 * time.Second,
 		MinTimeout: 10 * time.Second,
 	}
@@ -871,37 +866,38 @@ func waitForResourceInstanceUpdate(d *schema.ResourceData, meta interface{}) (in
 	return stateConf.WaitForState()
 }
 
-func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (interface{}, error) {
-	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
-	if err != nil {
-		return false, err
+// resourceInstanceOperationCurrent tells if the last operation reported by the
+// broker is the operation of type operationType that was just requested, and
+// not previous or another operation that ended before it
+func resourceInstanceOperationCurrent(lastOperation, previous map[string]interface{}, operationType string) bool {
+	if len(lastOperation) == 0 {
+		return false
 	}
-	instanceID := d.Id()
-	resourceInstanceGet := rc.GetResourceInstanceOptions{
-		ID: &instanceID,
+	if t, ok := lastOperation["type"].(string); ok && t != "" && t != operationType {
+		return false
 	}
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{RsInstanceProgressStatus, RsInstanceInactiveStatus, RsInstanceSuccessStatus},
-		Target:  []string{RsInstanceRemovedStatus, RsInstanceReclamation},
-		Refresh: func() (interface{}, string, error) {
-			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
-			if err != nil {
-				if resp != nil && resp.StatusCode == 404 {
-					return instance, RsInstanceSuccessStatus, nil
-				}
-				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
-			}
-			if *instance.State == RsInstanceFailStatus {
-				return instance, *instance.State, fmt.Errorf("[ERROR] The resource instance %s failed to delete: %v", d.Id(), err)
-			}
-			return instance, *instance.State, nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		Delay:      10 * time.Second,
-		MinTimeout: 10 * time.Second,
+	updatedAt, ok := lastOperation["updated_at"]
+	if !ok || updatedAt == nil || previous == nil {
+		return true
 	}
+	previousUpdatedAt, ok := previous["updated_at"]
+	return !ok || previousUpdatedAt == nil || fmt.Sprint(previousUpdatedAt) != fmt.Sprint(updatedAt)
+}
 
-	return stateConf.WaitForState()
+// resourceInstanceOperationFailure returns the reason of the failure of the
+// last operation reported by the broker
+func resourceInstanceOperationFailure(lastOperation map[string]interface{}) string {
+	reason := "no reason reported by the service"
+	if description, ok := lastOperation["description"].(string); ok && description != "" {
+		reason = description
+	}
+	if reasonCode, ok := lastOperation["reason_code"]; ok && reasonCode != nil {
+		reason = fmt.Sprintf("%s (reason code: %v)", reason, reasonCode)
+	}
+	if operationType, ok := lastOperation["type"].(string); ok && operationType != "" {
+		reason = fmt.Sprintf("%s operation: %s", operationType, reason)
+	}
+	return reason
 }
 
 func FilterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
@@ -929,3 +925,75 @@ func flattenPlanHistory(keys []rc.PlanHistoryItem) []interface{} {
 	}
 	return out
 }
+
+// resourceIBMResourceInstanceCatalogCustomizeDiff checks the service, plan
+// and location against the catalog, and the parameters against the ones the
+// plan describes, so that mistakes are reported at plan time
+func resourceIBMResourceInstanceCatalogCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && !diff.HasChange("plan") && !diff.HasChange("parameters") && !diff.HasChange("parameters_json") && !diff.HasChange("service_endpoints") {
+		return nil
+	}
+	for _, key := range []string{"service", "plan", "location", "service_endpoints", "parameters", "parameters_json"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	serviceName := diff.Get("service").(string)
+	plan := diff.Get("plan").(string)
+	serviceOff, servicePlan, err := getResourceCatalogServicePlan(meta, serviceName, plan)
+	if err != nil {
+		return err
+	}
+	if diff.Id() == "" {
+		if _, err = getResourceCatalogDeployment(meta, servicePlan, plan, diff.Get("location").(string)); err != nil {
+			return err
+		}
+	}
+
+	catalogParams, err := getResourceCatalogParameters(meta, serviceOff.ID, servicePlan)
+	if err != nil {
+		log.Printf("[WARN] Skipping the validation of the parameters of service %s: %s", serviceName, err)
+		return nil
+	}
+	// The parameters are the ones sent on creation
+	params := map[string]interface{}{}
+	if serviceEndpoints, ok := diff.GetOk("service_endpoints"); ok {
+		params["service-endpoints"] = serviceEndpoints.(string)
+	}
+	if parameters, ok := diff.GetOk("parameters"); ok {
+		expandResourceInstanceParameters(parameters.(map[string]interface{}), params)
+	}
+	if s, ok := diff.GetOk("parameters_json"); ok {
+		json.Unmarshal([]byte(s.(string)), &params)
+	}
+	return validateResourceCatalogParameters(params, catalogParams)
+}
+
+// expandResourceInstanceParameters adds the parameters to params, booleans
+// and lists written as strings are converted
+func expandResourceInstanceParameters(parameters map[string]interface{}, params map[string]interface{}) {
+	for k, v := range parameters {
+		if v == "true" || v == "false" {
+			b, _ := strconv.ParseBool(v.(string))
+			params[k] = b
+		} else if strings.HasPrefix(v.(string), "[") && strings.HasSuffix(v.(string), "]") {
+			//transform v.(string) to be []string
+			arrayString := v.(string)
+			result := []string{}
+			trimLeft := strings.TrimLeft(arrayString, "[")
+			trimRight := strings.TrimRight(trimLeft, "]")
+			if len(trimRight) == 0 {
+				params[k] = result
+			} else {
+				array := strings.Split(trimRight, ",")
+				for _, a := range array {
+					result = append(result, strings.Trim(a, "\""))
+				}
+				params[k] = result
+			}
+		} else {
+			params[k] = v
+		}
+	}
+}
@@ -5,6 +5,7 @@ package resourcecontroller_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -145,6 +146,103 @@ func TestAccIBMResourceInstanceWithResourceGroup(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceWaitForState(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceWaitForState(serviceName, "succeeded"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "last_operation.state", "succeeded"),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceWaitForState(serviceName+"-updated", "active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "last_operation.state", "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceInstanceInvalidCatalog(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMResourceInstanceCatalog(serviceName, "cloud-object-storage", "invalid-plan", "global"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No plan invalid-plan found for service cloud-object-storage`),
+			},
+			{
+				Config:      testAccCheckIBMResourceInstanceCatalog(serviceName, "kms", "tiered-pricing", "invalid-location"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No deployment found for service plan tiered-pricing at location invalid-location`),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceInstanceReclaimPolicy(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-kms-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_resource_instance.instance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstancePurged,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceReclaimPolicy(serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "reclaim_policy", "purge"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMResourceInstancePurged checks that the instance was removed
+// rather than left pending reclamation
+func testAccCheckIBMResourceInstancePurged(s *terraform.State) error {
+	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_resource_instance" {
+			continue
+		}
+
+		instance, resp, err := rsContClient.GetResourceInstance(rsContClient.NewGetResourceInstanceOptions(rs.Primary.ID))
+		if err == nil {
+			if *instance.State != "removed" {
+				return fmt.Errorf("Resource Instance is %s instead of removed: %s", *instance.State, rs.Primary.ID)
+			}
+		} else if resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410) {
+			return fmt.Errorf("[ERROR] Error checking if Resource Instance (%s) has been purged: %s with resp code: %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckIBMResourceInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -309,3 +407,45 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 			
 	`, serviceName)
 }
+
+func testAccCheckIBMResourceInstanceReclaimPolicy(serviceName string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_resource_instance" "instance" {
+		name           = "%s"
+		service        = "kms"
+		plan           = "tiered-pricing"
+		location       = "us-south"
+		reclaim_policy = "purge"
+	}
+	`, serviceName)
+}
+
+func testAccCheckIBMResourceInstanceCatalog(serviceName, service, plan, location string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "%s"
+		plan     = "%s"
+		location = "%s"
+	}
+	`, serviceName, service, plan, location)
+}
+
+func testAccCheckIBMResourceInstanceWaitForState(serviceName, waitForState string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name           = "%s"
+		service        = "kms"
+		plan           = "tiered-pricing"
+		location       = "us-south"
+		wait_for_state = "%s"
+
+		timeouts {
+		  create = "15m"
+		  update = "15m"
+		  delete = "15m"
+		}
+	}
+	`, serviceName, waitForState)
+}
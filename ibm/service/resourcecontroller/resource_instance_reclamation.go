@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcecontroller
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+const (
+	RsInstanceReclaimPolicyPurge           = "purge"
+	RsInstanceReclaimPolicyRestoreIfExists = "restore_if_exists"
+
+	rsReclamationActionReclaim = "reclaim"
+	rsReclamationActionRestore = "restore"
+)
+
+// findReclaimedResourceInstance returns the reclamation of an instance
+// pending reclamation with the same name, resource group and service, or
+// nil when there is none
+func findReclaimedResourceInstance(meta interface{}, name, resourceGroupID, resourceID string) (*rc.Reclamation, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+
+	reclamations, resp, err := rsConClient.ListReclamations(rsConClient.NewListReclamationsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing reclamations: %s with resp code: %s", err, resp)
+	}
+
+	for i, reclamation := range reclamations.Resources {
+		if reclamation.ResourceInstanceID == nil || (reclamation.ResourceGroupID != nil && *reclamation.ResourceGroupID != resourceGroupID) {
+			continue
+		}
+		instance, resp, err := rsConClient.GetResourceInstance(rsConClient.NewGetResourceInstanceOptions(*reclamation.ResourceInstanceID))
+		if err != nil {
+			log.Printf("[WARN] Error getting reclaimed resource instance %s: %s with resp code: %s", *reclamation.ResourceInstanceID, err, resp)
+			continue
+		}
+		if instance.Name != nil && *instance.Name == name &&
+			instance.ResourceGroupID != nil && *instance.ResourceGroupID == resourceGroupID &&
+			instance.ResourceID != nil && *instance.ResourceID == resourceID &&
+			instance.State != nil && *instance.State == RsInstanceReclamation {
+			return &reclamations.Resources[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// getResourceInstanceReclamation returns the reclamation of the instance, or
+// nil when the instance isn't pending reclamation
+func getResourceInstanceReclamation(meta interface{}, instanceID string) (*rc.Reclamation, error) {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+
+	listReclamationsOptions := rsConClient.NewListReclamationsOptions()
+	listReclamationsOptions.SetResourceInstanceID(instanceID)
+	reclamations, resp, err := rsConClient.ListReclamations(listReclamationsOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing reclamations of resource instance %s: %s with resp code: %s", instanceID, err, resp)
+	}
+	if len(reclamations.Resources) == 0 {
+		return nil, nil
+	}
+	return &reclamations.Resources[0], nil
+}
+
+// runResourceInstanceReclamationAction reclaims or restores the instance of
+// the reclamation, and waits for the instance to be removed or active
+func runResourceInstanceReclamationAction(meta interface{}, reclamation *rc.Reclamation, action string, timeout time.Duration) error {
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	instanceID := *reclamation.ResourceInstanceID
+	_, resp, err := rsConClient.RunReclamationAction(rsConClient.NewRunReclamationActionOptions(*reclamation.ID, action))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error running %s on reclamation of resource instance %s: %s with resp code: %s", action, instanceID, err, resp)
+	}
+
+	target := RsInstanceRemovedStatus
+	if action == rsReclamationActionRestore {
+		target = RsInstanceSuccessStatus
+	}
+	resourceInstanceGet := rsConClient.NewGetResourceInstanceOptions(instanceID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{RsInstanceReclamation, RsInstanceProgressStatus, RsInstanceInactiveStatus},
+		Target:  []string{target},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := rsConClient.GetResourceInstance(resourceInstanceGet)
+			if err != nil {
+				if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) && target == RsInstanceRemovedStatus {
+					return &rc.ResourceInstance{}, RsInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Get the resource instance %s failed with resp code: %s, err: %v", instanceID, resp, err)
+			}
+			if *instance.State == RsInstanceFailStatus {
+				return instance, *instance.State, fmt.Errorf("[ERROR] The %s of resource instance %s failed", action, instanceID)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	return err
+}
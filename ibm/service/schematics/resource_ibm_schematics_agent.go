@@ -0,0 +1,437 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMSchematicsAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsAgentCreate,
+		ReadContext:   resourceIBMSchematicsAgentRead,
+		UpdateContext: resourceIBMSchematicsAgentUpdate,
+		DeleteContext: resourceIBMSchematicsAgentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "name"),
+				Description:  "The name of the agent (must be unique, for an account).",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Agent description.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The resource-group name for the agent.  By default, agent will be registered in Default Resource Group.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags for the agent.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Agent version.",
+			},
+			"schematics_location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "schematics_location"),
+				Description:  "The Schematics location the agent is registered with.",
+			},
+			"agent_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location where agent is deployed in the user environment.",
+			},
+			"agent_infrastructure": {
+				Type:        schema.TypeList,
+				MinItems:    1,
+				MaxItems:    1,
+				Required:    true,
+				Description: "The infrastructure parameters used by the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"infra_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "infra_type"),
+							Description:  "Type of target agent infrastructure.",
+						},
+						"cluster_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The cluster to deploy the agent.",
+						},
+						"cluster_resource_group": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The resource group of the cluster.",
+						},
+						"cos_instance_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The COS instance name to store the agent logs.",
+						},
+						"cos_bucket_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The COS bucket name used to store the logs.",
+						},
+						"cos_bucket_region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The COS bucket region.",
+						},
+					},
+				},
+			},
+			"agent_metadata": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The metadata of an agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the metadata.",
+						},
+						"value": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Value of the metadata name.",
+						},
+					},
+				},
+			},
+			"user_state": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Computed:    true,
+				Description: "User defined status of the agent.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"state": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_schematics_agent", "state"),
+							Description:  "User-defined states * `enable`  Agent is enabled by the user. * `disable` Agent is disabled by the user.",
+						},
+						"set_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the User who set the state of the Object.",
+						},
+						"set_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the User who set the state of the Object.",
+						},
+					},
+				},
+			},
+			"agent_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent crn, obtained from the Schematics agent deployment configuration.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent creation date-time.",
+			},
+			"creation_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of an user who created the agent.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent registration updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who updated the agent registration.",
+			},
+		},
+	}
+}
+
+func ResourceIBMSchematicsAgentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			MinValueLength:             1,
+			MaxValueLength:             64,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "schematics_location",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "ca-tor, eu-de, eu-gb, us-east, us-south",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "infra_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "ibm_kubernetes, ibm_openshift, ibm_satellite",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "state",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "disable, enable",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_schematics_agent", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSchematicsAgentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agent := resourceIBMSchematicsAgentMapToAgent(d)
+	agent.SchematicsLocation = core.StringPtr(d.Get("schematics_location").(string))
+	agent.AgentLocation = core.StringPtr(d.Get("agent_location").(string))
+
+	response, err := schematicsRequest(context, schematicsClient, core.POST, "/v2/agents", nil, nil, "", agent, agent)
+	if err != nil {
+		log.Printf("[DEBUG] CreateAgentDataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateAgentDataWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*agent.ID)
+
+	return resourceIBMSchematicsAgentRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	agent := &schematicsAgent{}
+	response, err := schematicsRequest(context, schematicsClient, core.GET, "/v2/agents/{agent_id}", map[string]string{"agent_id": d.Id()}, map[string]string{"profile": "detailed"}, "", nil, agent)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAgentDataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAgentDataWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", agent.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", agent.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if err = d.Set("resource_group", agent.ResourceGroup); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+	}
+	if agent.Tags != nil {
+		if err = d.Set("tags", agent.Tags); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
+		}
+	}
+	if err = d.Set("version", agent.Version); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting version: %s", err))
+	}
+	if err = d.Set("schematics_location", agent.SchematicsLocation); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting schematics_location: %s", err))
+	}
+	if err = d.Set("agent_location", agent.AgentLocation); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_location: %s", err))
+	}
+	if agent.AgentInfrastructure != nil {
+		if err = d.Set("agent_infrastructure", []map[string]interface{}{resourceIBMSchematicsAgentInfrastructureToMap(agent.AgentInfrastructure)}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_infrastructure: %s", err))
+		}
+	}
+	if agent.AgentMetadata != nil {
+		agentMetadata := []map[string]interface{}{}
+		for _, metadata := range agent.AgentMetadata {
+			agentMetadata = append(agentMetadata, map[string]interface{}{
+				"name":  metadata.Name,
+				"value": metadata.Value,
+			})
+		}
+		if err = d.Set("agent_metadata", agentMetadata); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_metadata: %s", err))
+		}
+	}
+	if agent.UserState != nil {
+		userState := map[string]interface{}{
+			"state":  agent.UserState.State,
+			"set_by": agent.UserState.SetBy,
+			"set_at": agent.UserState.SetAt,
+		}
+		if err = d.Set("user_state", []map[string]interface{}{userState}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting user_state: %s", err))
+		}
+	}
+	if err = d.Set("agent_crn", agent.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting agent_crn: %s", err))
+	}
+	if err = d.Set("created_at", agent.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("creation_by", agent.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting creation_by: %s", err))
+	}
+	if err = d.Set("updated_at", agent.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+	if err = d.Set("updated_by", agent.UpdatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_by: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("resource_group") || d.HasChange("tags") ||
+		d.HasChange("version") || d.HasChange("agent_infrastructure") || d.HasChange("agent_metadata") || d.HasChange("user_state") {
+		agent := resourceIBMSchematicsAgentMapToAgent(d)
+		response, err := schematicsRequest(context, schematicsClient, core.PUT, "/v2/agents/{agent_id}", map[string]string{"agent_id": d.Id()}, nil, "", agent, &schematicsAgent{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAgentDataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateAgentDataWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSchematicsAgentRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	iamRefreshToken := session.Config.IAMRefreshToken
+
+	response, err := schematicsRequest(context, schematicsClient, core.DELETE, "/v2/agents/{agent_id}", map[string]string{"agent_id": d.Id()}, nil, iamRefreshToken, nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteAgentDataWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteAgentDataWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMSchematicsAgentMapToAgent builds the agent of the create and
+// update requests, the locations of an agent can't be updated
+func resourceIBMSchematicsAgentMapToAgent(d *schema.ResourceData) *schematicsAgent {
+	agent := &schematicsAgent{
+		Name:          core.StringPtr(d.Get("name").(string)),
+		ResourceGroup: core.StringPtr(d.Get("resource_group").(string)),
+		Version:       core.StringPtr(d.Get("version").(string)),
+		Tags:          flex.ExpandStringList(d.Get("tags").([]interface{})),
+	}
+	if _, ok := d.GetOk("description"); ok {
+		agent.Description = core.StringPtr(d.Get("description").(string))
+	}
+	if l, ok := d.GetOk("agent_infrastructure"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		agent.AgentInfrastructure = resourceIBMSchematicsAgentMapToInfrastructure(l.([]interface{})[0].(map[string]interface{}))
+	}
+	if l, ok := d.GetOk("agent_metadata"); ok {
+		for _, item := range l.([]interface{}) {
+			m := item.(map[string]interface{})
+			metadata := schematicsAgentMetadata{
+				Value: flex.ExpandStringList(m["value"].([]interface{})),
+			}
+			if m["name"] != nil && m["name"].(string) != "" {
+				metadata.Name = core.StringPtr(m["name"].(string))
+			}
+			agent.AgentMetadata = append(agent.AgentMetadata, metadata)
+		}
+	}
+	if l, ok := d.GetOk("user_state"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		m := l.([]interface{})[0].(map[string]interface{})
+		if m["state"] != nil && m["state"].(string) != "" {
+			agent.UserState = &schematicsAgentUserState{State: core.StringPtr(m["state"].(string))}
+		}
+	}
+	return agent
+}
+
+func resourceIBMSchematicsAgentMapToInfrastructure(m map[string]interface{}) *schematicsAgentInfrastructure {
+	infrastructure := &schematicsAgentInfrastructure{}
+	set := func(key string) *string {
+		if m[key] != nil && m[key].(string) != "" {
+			return core.StringPtr(m[key].(string))
+		}
+		return nil
+	}
+	infrastructure.InfraType = set("infra_type")
+	infrastructure.ClusterID = set("cluster_id")
+	infrastructure.ClusterResourceGroup = set("cluster_resource_group")
+	infrastructure.CosInstanceName = set("cos_instance_name")
+	infrastructure.CosBucketName = set("cos_bucket_name")
+	infrastructure.CosBucketRegion = set("cos_bucket_region")
+	return infrastructure
+}
+
+func resourceIBMSchematicsAgentInfrastructureToMap(infrastructure *schematicsAgentInfrastructure) map[string]interface{} {
+	return map[string]interface{}{
+		"infra_type":             infrastructure.InfraType,
+		"cluster_id":             infrastructure.ClusterID,
+		"cluster_resource_group": infrastructure.ClusterResourceGroup,
+		"cos_instance_name":      infrastructure.CosInstanceName,
+		"cos_bucket_name":        infrastructure.CosBucketName,
+		"cos_bucket_region":      infrastructure.CosBucketRegion,
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// ResourceIBMSchematicsAgentDeploy deploys an agent to its infrastructure and
+// waits until the agent is healthy
+func ResourceIBMSchematicsAgentDeploy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsAgentDeployCreate,
+		ReadContext:   resourceIBMSchematicsAgentDeployRead,
+		UpdateContext: resourceIBMSchematicsAgentDeployUpdate,
+		DeleteContext: resourceIBMSchematicsAgentDeployDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Agent ID to get the details of agent.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Equivalent to -force options in the command line, deploys the agent even if it is already deployed.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that deploy the agent again when they change.",
+			},
+			"job_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Job Id.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The agent deploy job updation time.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Email address of user who ran the agent deploy job.",
+			},
+			"is_redeployed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True, when the same version of the agent was redeployed.",
+			},
+			"status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the agent deploy job.",
+			},
+			"status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the agent deploy job, in a formatted log string.",
+			},
+			"log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL to the full agent deployment job logs.",
+			},
+			"health_status_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Final result of the agent health job.",
+			},
+			"health_status_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The outcome of the agent health job, in a formatted log string.",
+			},
+			"health_log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL to the full agent health job logs.",
+			},
+		},
+	}
+}
+
+func resourceIBMSchematicsAgentDeployCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	agentID := d.Get("agent_id").(string)
+	if err := deploySchematicsAgent(context, d, meta, agentID, d.Get("force").(bool), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSchematicsAgentDeployRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentDeployRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	job := &schematicsAgentJob{}
+	response, err := schematicsRequest(context, schematicsClient, core.GET, "/v2/agents/{agent_id}/deploy", map[string]string{"agent_id": d.Id()}, nil, "", nil, job)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAgentDeployJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAgentDeployJobWithContext failed %s\n%s", err, response))
+	}
+	d.Set("agent_id", d.Id())
+	setSchematicsAgentDeployJob(d, job)
+
+	healthJob := &schematicsAgentJob{}
+	response, err = schematicsRequest(context, schematicsClient, core.GET, "/v2/agents/{agent_id}/health", map[string]string{"agent_id": d.Id()}, nil, "", nil, healthJob)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] GetAgentHealthJobWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAgentHealthJobWithContext failed %s\n%s", err, response))
+	}
+	if err == nil {
+		setSchematicsAgentHealthJob(d, healthJob)
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsAgentDeployUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The agent is already deployed, it's deployed again with force
+	if d.HasChange("triggers") || (d.HasChange("force") && d.Get("force").(bool)) {
+		if err := deploySchematicsAgent(context, d, meta, d.Id(), true, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			// The state keeps the triggers of the last deploy, so that the
+			// next apply deploys the agent again
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSchematicsAgentDeployRead(context, d, meta)
+}
+
+func resourceIBMSchematicsAgentDeployDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The deployment is removed with the agent
+	d.SetId("")
+
+	return nil
+}
+
+// deploySchematicsAgent runs the deploy job of the agent, then its health
+// job, and waits for both to finish
+func deploySchematicsAgent(context context.Context, d *schema.ResourceData, meta interface{}, agentID string, force bool, timeout time.Duration) error {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return err
+	}
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	iamRefreshToken := session.Config.IAMRefreshToken
+
+	job := &schematicsAgentJob{}
+	query := map[string]string{"force": strconv.FormatBool(force)}
+	response, err := schematicsRequest(context, schematicsClient, core.PUT, "/v2/agents/{agent_id}/deploy", map[string]string{"agent_id": agentID}, query, iamRefreshToken, nil, job)
+	if err != nil {
+		log.Printf("[DEBUG] DeployAgentJobWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("DeployAgentJobWithContext failed %s\n%s", err, response)
+	}
+	d.SetId(agentID)
+	log.Printf("[INFO] Started deploy job (%s) of agent (%s)", core.StringNilMapper(job.JobID), agentID)
+
+	start := time.Now()
+	job, err = waitForSchematicsAgentJob(context, schematicsClient, agentID, "deploy", timeout)
+	if job != nil {
+		setSchematicsAgentDeployJob(d, job)
+	}
+	if err != nil {
+		return err
+	}
+
+	healthJob := &schematicsAgentJob{}
+	response, err = schematicsRequest(context, schematicsClient, core.PUT, "/v2/agents/{agent_id}/health", map[string]string{"agent_id": agentID}, nil, iamRefreshToken, nil, healthJob)
+	if err != nil {
+		log.Printf("[DEBUG] HealthCheckAgentJobWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("HealthCheckAgentJobWithContext failed %s\n%s", err, response)
+	}
+	healthJob, err = waitForSchematicsAgentJob(context, schematicsClient, agentID, "health", timeout-time.Since(start))
+	if healthJob != nil {
+		setSchematicsAgentHealthJob(d, healthJob)
+	}
+	return err
+}
+
+func setSchematicsAgentDeployJob(d *schema.ResourceData, job *schematicsAgentJob) {
+	d.Set("job_id", job.JobID)
+	d.Set("updated_at", job.UpdatedAt)
+	d.Set("updated_by", job.UpdatedBy)
+	d.Set("is_redeployed", job.IsRedeployed)
+	d.Set("status_code", job.StatusCode)
+	d.Set("status_message", job.StatusMessage)
+	d.Set("log_url", job.LogURL)
+}
+
+func setSchematicsAgentHealthJob(d *schema.ResourceData, job *schematicsAgentJob) {
+	d.Set("health_status_code", job.StatusCode)
+	d.Set("health_status_message", job.StatusMessage)
+	d.Set("health_log_url", job.LogURL)
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsAgentDeployBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-agent-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsAgentDeployConfig(name, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_schematics_agent_deploy.schematics_agent_deploy", "agent_id", "ibm_schematics_agent.schematics_agent", "id"),
+					resource.TestCheckResourceAttr("ibm_schematics_agent_deploy.schematics_agent_deploy", "status_code", "job_finished"),
+					resource.TestCheckResourceAttr("ibm_schematics_agent_deploy.schematics_agent_deploy", "health_status_code", "job_finished"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_agent_deploy.schematics_agent_deploy", "job_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsAgentDeployConfig(name, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_agent_deploy.schematics_agent_deploy", "status_code", "job_finished"),
+					resource.TestCheckResourceAttr("ibm_schematics_agent_deploy.schematics_agent_deploy", "is_redeployed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsAgentDeployConfig(name string, revision string) string {
+	return testAccCheckIBMSchematicsAgentConfig(name, "tf-acc-test-schematics-agent") + fmt.Sprintf(`
+
+		resource "ibm_schematics_agent_deploy" "schematics_agent_deploy" {
+			agent_id = ibm_schematics_agent.schematics_agent.id
+			triggers = {
+				revision = "%s"
+			}
+		}
+	`, revision)
+}
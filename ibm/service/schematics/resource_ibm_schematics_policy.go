@@ -0,0 +1,412 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMSchematicsPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsPolicyCreate,
+		ReadContext:   resourceIBMSchematicsPolicyRead,
+		UpdateContext: resourceIBMSchematicsPolicyUpdate,
+		DeleteContext: resourceIBMSchematicsPolicyDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "name"),
+				Description:  "Name of Schematics customization policy.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of Schematics customization policy.",
+			},
+			"resource_group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The resource group name for the policy.  By default, Policy will be created in `default` Resource Group.",
+			},
+			"tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags for the Schematics customization policy.",
+			},
+			"location": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "location"),
+				Description:  "List of locations supported by IBM Cloud Schematics service.  While creating your workspace or action, choose the right region, since it cannot be changed.  Note, this does not limit the location of the IBM Cloud resources, provisioned using Schematics.",
+			},
+			"kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      schematicsPolicyKindAgentAssignment,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "kind"),
+				Description:  "Policy kind or categories for managing and deriving policy decision  * `agent_assignment_policy` Agent assignment policy for job execution.",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The objects for the Schematics policy.",
+				Elem:        resourceIBMSchematicsPolicySelectorSchema("objects"),
+			},
+			"parameter": {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "The parameter to tune the Schematics policy.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"agent_assignment_policy_parameter": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "Parameters for the `agent_assignment_policy`, the agents that run the jobs of the target objects.",
+							Elem:        resourceIBMSchematicsPolicySelectorSchema("agents"),
+						},
+					},
+				},
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy CRN.",
+			},
+			"account": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Account id.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy creation time.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who created the policy.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy updation time.",
+			},
+		},
+	}
+}
+
+// resourceIBMSchematicsPolicySelectorSchema is the schema of the target and
+// of the agent assignment parameter, which select objects and agents alike
+func resourceIBMSchematicsPolicySelectorSchema(selected string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"selector_kind": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "selector_kind"),
+				Description:  fmt.Sprintf("Types of selector for the %s: `ids` selects them by ID, `scoped` by scope.", selected),
+			},
+			"selector_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("The IDs of the %s.", selected),
+			},
+			"selector_scope": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: fmt.Sprintf("The scopes of the %s.", selected),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_schematics_policy", "scope_kind"),
+							Description:  "Name of the Schematics automation resource.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tag based selector.",
+						},
+						"resource_groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The resource group based selector.",
+						},
+						"locations": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The location based selector.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceIBMSchematicsPolicyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.StringLenBetween,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			MinValueLength:             1,
+			MaxValueLength:             64,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "location",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ca-tor, eu-de, eu-gb, us-east, us-south",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "kind",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              schematicsPolicyKindAgentAssignment,
+		},
+		validate.ValidateSchema{
+			Identifier:                 "selector_kind",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "ids, scoped",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "scope_kind",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "action, agent, blueprint, environment, system, workspace",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_schematics_policy", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSchematicsPolicyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := resourceIBMSchematicsPolicyMapToPolicy(d)
+	policy.Kind = core.StringPtr(d.Get("kind").(string))
+	if _, ok := d.GetOk("location"); ok {
+		policy.Location = core.StringPtr(d.Get("location").(string))
+	}
+
+	response, err := schematicsRequest(context, schematicsClient, core.POST, "/v2/settings/policies", nil, nil, "", policy, policy)
+	if err != nil {
+		log.Printf("[DEBUG] CreatePolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreatePolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(*policy.ID)
+
+	return resourceIBMSchematicsPolicyRead(context, d, meta)
+}
+
+func resourceIBMSchematicsPolicyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := &schematicsPolicy{}
+	response, err := schematicsRequest(context, schematicsClient, core.GET, "/v2/settings/policies/{policy_id}", map[string]string{"policy_id": d.Id()}, map[string]string{"profile": "detailed"}, "", nil, policy)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetPolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetPolicyWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("name", policy.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting name: %s", err))
+	}
+	if err = d.Set("description", policy.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting description: %s", err))
+	}
+	if err = d.Set("resource_group", policy.ResourceGroup); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resource_group: %s", err))
+	}
+	if policy.Tags != nil {
+		if err = d.Set("tags", policy.Tags); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting tags: %s", err))
+		}
+	}
+	if err = d.Set("location", policy.Location); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting location: %s", err))
+	}
+	if err = d.Set("kind", policy.Kind); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting kind: %s", err))
+	}
+	if policy.Target != nil {
+		if err = d.Set("target", []map[string]interface{}{resourceIBMSchematicsPolicySelectorToMap(policy.Target)}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting target: %s", err))
+		}
+	}
+	if policy.Parameter != nil {
+		parameter := map[string]interface{}{}
+		if policy.Parameter.AgentAssignmentPolicyParameter != nil {
+			parameter["agent_assignment_policy_parameter"] = []map[string]interface{}{resourceIBMSchematicsPolicySelectorToMap(policy.Parameter.AgentAssignmentPolicyParameter)}
+		}
+		if err = d.Set("parameter", []map[string]interface{}{parameter}); err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error setting parameter: %s", err))
+		}
+	}
+	if err = d.Set("crn", policy.CRN); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting crn: %s", err))
+	}
+	if err = d.Set("account", policy.Account); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting account: %s", err))
+	}
+	if err = d.Set("created_at", policy.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_at: %s", err))
+	}
+	if err = d.Set("created_by", policy.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting created_by: %s", err))
+	}
+	if err = d.Set("updated_at", policy.UpdatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting updated_at: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSchematicsPolicyUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("resource_group") || d.HasChange("tags") ||
+		d.HasChange("target") || d.HasChange("parameter") {
+		policy := resourceIBMSchematicsPolicyMapToPolicy(d)
+		response, err := schematicsRequest(context, schematicsClient, core.PATCH, "/v2/settings/policies/{policy_id}", map[string]string{"policy_id": d.Id()}, nil, "", policy, &schematicsPolicy{})
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePolicyWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdatePolicyWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSchematicsPolicyRead(context, d, meta)
+}
+
+func resourceIBMSchematicsPolicyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := schematicsRequest(context, schematicsClient, core.DELETE, "/v2/settings/policies/{policy_id}", map[string]string{"policy_id": d.Id()}, nil, "", nil, nil)
+	if err != nil {
+		log.Printf("[DEBUG] DeletePolicyWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeletePolicyWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMSchematicsPolicyMapToPolicy builds the policy of the create and
+// update requests, the location and kind of a policy can't be updated
+func resourceIBMSchematicsPolicyMapToPolicy(d *schema.ResourceData) *schematicsPolicy {
+	policy := &schematicsPolicy{
+		Name: core.StringPtr(d.Get("name").(string)),
+		Tags: flex.ExpandStringList(d.Get("tags").([]interface{})),
+	}
+	if _, ok := d.GetOk("description"); ok {
+		policy.Description = core.StringPtr(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("resource_group"); ok {
+		policy.ResourceGroup = core.StringPtr(d.Get("resource_group").(string))
+	}
+	if l, ok := d.GetOk("target"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		policy.Target = resourceIBMSchematicsPolicyMapToSelector(l.([]interface{})[0].(map[string]interface{}))
+	}
+	if l, ok := d.GetOk("parameter"); ok && len(l.([]interface{})) > 0 && l.([]interface{})[0] != nil {
+		policy.Parameter = &schematicsPolicyParameter{}
+		m := l.([]interface{})[0].(map[string]interface{})
+		if p, ok := m["agent_assignment_policy_parameter"].([]interface{}); ok && len(p) > 0 && p[0] != nil {
+			policy.Parameter.AgentAssignmentPolicyParameter = resourceIBMSchematicsPolicyMapToSelector(p[0].(map[string]interface{}))
+		}
+	}
+	return policy
+}
+
+func resourceIBMSchematicsPolicyMapToSelector(m map[string]interface{}) *schematicsPolicySelector {
+	selector := &schematicsPolicySelector{
+		SelectorIds: flex.ExpandStringList(m["selector_ids"].([]interface{})),
+	}
+	if m["selector_kind"] != nil && m["selector_kind"].(string) != "" {
+		selector.SelectorKind = core.StringPtr(m["selector_kind"].(string))
+	}
+	for _, item := range m["selector_scope"].([]interface{}) {
+		scopeMap := item.(map[string]interface{})
+		scope := schematicsPolicyScope{
+			Tags:           flex.ExpandStringList(scopeMap["tags"].([]interface{})),
+			ResourceGroups: flex.ExpandStringList(scopeMap["resource_groups"].([]interface{})),
+			Locations:      flex.ExpandStringList(scopeMap["locations"].([]interface{})),
+		}
+		if scopeMap["kind"] != nil && scopeMap["kind"].(string) != "" {
+			scope.Kind = core.StringPtr(scopeMap["kind"].(string))
+		}
+		selector.SelectorScope = append(selector.SelectorScope, scope)
+	}
+	return selector
+}
+
+func resourceIBMSchematicsPolicySelectorToMap(selector *schematicsPolicySelector) map[string]interface{} {
+	selectorScope := []map[string]interface{}{}
+	for _, scope := range selector.SelectorScope {
+		selectorScope = append(selectorScope, map[string]interface{}{
+			"kind":            scope.Kind,
+			"tags":            scope.Tags,
+			"resource_groups": scope.ResourceGroups,
+			"locations":       scope.Locations,
+		})
+	}
+	return map[string]interface{}{
+		"selector_kind":  selector.SelectorKind,
+		"selector_ids":   selector.SelectorIds,
+		"selector_scope": selectorScope,
+	}
+}
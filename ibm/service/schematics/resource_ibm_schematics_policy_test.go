@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsPolicyBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-policy-%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf-acc-test-policy-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsPolicyConfig(name, "dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "name", name),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "kind", "agent_assignment_policy"),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "target.0.selector_scope.0.tags.0", "env:dev"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_policy.schematics_policy", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsPolicyConfig(nameUpdate, "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_schematics_policy.schematics_policy", "target.0.selector_scope.0.tags.0", "env:prod"),
+				),
+			},
+			{
+				ResourceName:      "ibm_schematics_policy.schematics_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsPolicyConfig(name string, env string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_policy" "schematics_policy" {
+			name           = "%s"
+			description    = "tf-acc-test-schematics-policy"
+			resource_group = "default"
+			location       = "us-south"
+			target {
+				selector_kind = "scoped"
+				selector_scope {
+					kind            = "workspace"
+					tags            = ["env:%s"]
+					resource_groups = ["default"]
+					locations       = ["us-south"]
+				}
+			}
+			parameter {
+				agent_assignment_policy_parameter {
+					selector_kind = "ids"
+					selector_ids  = ["%s"]
+				}
+			}
+		}
+	`, name, env, acc.SchematicsAgentID)
+}
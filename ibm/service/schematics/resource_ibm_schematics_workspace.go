@@ -32,6 +32,7 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 		ReadContext:   resourceIBMSchematicsWorkspaceRead,
 		UpdateContext: resourceIBMSchematicsWorkspaceUpdate,
 		DeleteContext: resourceIBMSchematicsWorkspaceDelete,
+		CustomizeDiff: resourceIBMSchematicsWorkspaceTemplateBundleDiff,
 		Importer:      &schema.ResourceImporter{},
 
 		Schema: map[string]*schema.Schema{
@@ -300,6 +301,17 @@ func ResourceIBMSchematicsWorkspace() *schema.Resource {
 				Computed:    true,
 				Description: "Has uploaded git repo tar",
 			},
+			"template_bundle": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"template_git_url", "template_git_repo_url"},
+				Description:   "The path of a local directory or tar.gz file with the Terraform template, uploaded to the workspace instead of a Git repository.",
+			},
+			"template_bundle_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 of the last uploaded template bundle, the bundle is uploaded again when its hash changes.",
+			},
 			/*"template_type": {
 				Type:        schema.TypeList,
 				Required:    true,
@@ -642,6 +654,12 @@ func resourceIBMSchematicsWorkspaceCreate(context context.Context, d *schema.Res
 
 	d.SetId(*workspaceResponse.ID)
 
+	if _, ok := d.GetOk("template_bundle"); ok {
+		if err = uploadSchematicsTemplateBundle(context, d, schematicsClient, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMSchematicsWorkspaceRead(context, d, meta)
 }
 
@@ -1430,7 +1448,16 @@ func resourceIBMSchematicsWorkspaceWorkspaceStatusMessageToMap(workspaceStatusMe
 	return workspaceStatusMessageMap
 }
 
-func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	// A failed update keeps the hash of the last upload, so that the next
+	// apply uploads the template bundle again
+	defer func() {
+		if diags.HasError() {
+			oldHash, _ := d.GetChange("template_bundle_hash")
+			d.Set("template_bundle_hash", oldHash)
+		}
+	}()
+
 	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
 	if err != nil {
 		return diag.FromErr(err)
@@ -1661,6 +1688,12 @@ func resourceIBMSchematicsWorkspaceUpdate(context context.Context, d *schema.Res
 
 	}
 
+	if _, ok := d.GetOk("template_bundle"); ok && d.HasChange("template_bundle_hash") {
+		if err = uploadSchematicsTemplateBundle(context, d, schematicsClient, d.Id()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMSchematicsWorkspaceRead(context, d, meta)
 }
 
@@ -0,0 +1,504 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+)
+
+const (
+	schematicsWorkspaceRunPlan    = "plan"
+	schematicsWorkspaceRunApply   = "apply"
+	schematicsWorkspaceRunDestroy = "destroy"
+
+	schematicsActivityCompleted = "COMPLETED"
+	schematicsActivityFailed    = "FAILED"
+	schematicsActivityStopped   = "STOPPED"
+)
+
+// ResourceIBMSchematicsWorkspaceRun runs a plan, apply or destroy job of a
+// Schematics workspace when it is created and whenever its triggers change
+func ResourceIBMSchematicsWorkspaceRun() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSchematicsWorkspaceRunCreate,
+		ReadContext:   resourceIBMSchematicsWorkspaceRunRead,
+		UpdateContext: resourceIBMSchematicsWorkspaceRunUpdate,
+		DeleteContext: resourceIBMSchematicsWorkspaceRunDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMSchematicsWorkspaceRunImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the workspace to run the job on.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      schematicsWorkspaceRunApply,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_workspace_run", "action"),
+				Description:  "The job to run when the resource is created and when the triggers change: plan, apply or destroy.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that run the job again when they change, for example the commit of the template or a hash of the workspace variables.",
+			},
+			"targets": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Terraform resources to target with the apply or destroy job.",
+			},
+			"tf_vars": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The Terraform variables passed to the apply or destroy job, in the form name=value.",
+			},
+			"destroy_on_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Runs a destroy job on the workspace when the resource is deleted.",
+			},
+			"log_excerpt_lines": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validate.InvokeValidator("ibm_schematics_workspace_run", "log_excerpt_lines"),
+				Description:  "The number of lines at the end of the job log that are kept in log_excerpt and reported as a warning, or as the error of a failed job.",
+			},
+			"activity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the last job run on the workspace.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the last job.",
+			},
+			"log_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the log of the last job.",
+			},
+			"log_excerpt": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last lines of the log of the last job.",
+			},
+			"outputs": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The outputs of the workspace, complex values are encoded in JSON.",
+			},
+		},
+	}
+}
+
+func ResourceIBMSchematicsWorkspaceRunValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "action",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "apply, destroy, plan",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "log_excerpt_lines",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "1000",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{ResourceName: "ibm_schematics_workspace_run", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSchematicsWorkspaceRunCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	workspaceID := d.Get("workspace_id").(string)
+	activityID, err := runSchematicsWorkspaceJob(context, d, meta, workspaceID, d.Get("action").(string), d.Timeout(schema.TimeoutCreate))
+	if activityID == "" {
+		return diag.FromErr(err)
+	}
+	// The first job tells runs of the same workspace apart
+	d.SetId(fmt.Sprintf("%s/%s", workspaceID, activityID))
+	d.Set("activity_id", activityID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(schematicsWorkspaceRunLogWarning(d), resourceIBMSchematicsWorkspaceRunRead(context, d, meta)...)
+}
+
+func resourceIBMSchematicsWorkspaceRunRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	workspaceID := parts[0]
+
+	getWorkspaceOptions := &schematicsv1.GetWorkspaceOptions{}
+	getWorkspaceOptions.SetWID(workspaceID)
+	_, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response))
+	}
+	d.Set("workspace_id", workspaceID)
+
+	if activityID, ok := d.GetOk("activity_id"); ok {
+		getWorkspaceActivityOptions := &schematicsv1.GetWorkspaceActivityOptions{}
+		getWorkspaceActivityOptions.SetWID(workspaceID)
+		getWorkspaceActivityOptions.SetActivityID(activityID.(string))
+		activity, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] GetWorkspaceActivityWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetWorkspaceActivityWithContext failed %s\n%s", err, response))
+		}
+		if err == nil {
+			d.Set("status", activity.Status)
+			if len(activity.Templates) > 0 {
+				d.Set("log_url", activity.Templates[0].LogURL)
+			}
+		}
+	}
+
+	// The outputs of the last refresh are kept when they can't be fetched,
+	// the workspace itself exists
+	outputs, err := getSchematicsWorkspaceOutputs(context, schematicsClient, workspaceID)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("The outputs of workspace (%s) couldn't be read", workspaceID),
+				Detail:   err.Error(),
+			},
+		}
+	}
+	if err = d.Set("outputs", outputs); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting outputs: %s", err))
+	}
+
+	return nil
+}
+
+// resourceIBMSchematicsWorkspaceRunImport imports the run of the job
+// workspaceID/activityID, the action is the one of the job
+func resourceIBMSchematicsWorkspaceRunImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return nil, err
+	}
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of workspaceID/activityID", d.Id())
+	}
+
+	getWorkspaceActivityOptions := &schematicsv1.GetWorkspaceActivityOptions{}
+	getWorkspaceActivityOptions.SetWID(parts[0])
+	getWorkspaceActivityOptions.SetActivityID(parts[1])
+	activity, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting job (%s) of workspace (%s): %s\n%s", parts[1], parts[0], err, response)
+	}
+
+	action := strings.ToLower(core.StringNilMapper(activity.Name))
+	switch action {
+	case schematicsWorkspaceRunPlan, schematicsWorkspaceRunApply, schematicsWorkspaceRunDestroy:
+	default:
+		return nil, fmt.Errorf("[ERROR] The job (%s) of workspace (%s) is a %s job, only plan, apply and destroy jobs can be imported", parts[1], parts[0], core.StringNilMapper(activity.Name))
+	}
+	d.Set("workspace_id", parts[0])
+	d.Set("activity_id", parts[1])
+	d.Set("action", action)
+	d.Set("destroy_on_delete", false)
+	d.Set("log_excerpt_lines", 30)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceIBMSchematicsWorkspaceRunUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The job runs again when the triggers or the job itself change, the
+	// other arguments only affect the jobs to come
+	if d.HasChange("triggers") || d.HasChange("action") || d.HasChange("targets") || d.HasChange("tf_vars") {
+		activityID, err := runSchematicsWorkspaceJob(context, d, meta, d.Get("workspace_id").(string), d.Get("action").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			// The state keeps the triggers and the job of the last run, so
+			// that the next apply runs the job again
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		d.Set("activity_id", activityID)
+		return append(schematicsWorkspaceRunLogWarning(d), resourceIBMSchematicsWorkspaceRunRead(context, d, meta)...)
+	}
+
+	return resourceIBMSchematicsWorkspaceRunRead(context, d, meta)
+}
+
+func resourceIBMSchematicsWorkspaceRunDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("destroy_on_delete").(bool) {
+		_, err := runSchematicsWorkspaceJob(context, d, meta, d.Get("workspace_id").(string), schematicsWorkspaceRunDestroy, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// runSchematicsWorkspaceJob starts the job action on the workspace, waits for
+// it to end and sets the status, log_url and log_excerpt of the job. The ID of
+// the job is returned as soon as it started, along with the error of a failed job.
+func runSchematicsWorkspaceJob(context context.Context, d *schema.ResourceData, meta interface{}, workspaceID, action string, timeout time.Duration) (string, error) {
+	schematicsClient, err := meta.(conns.ClientSession).SchematicsV1()
+	if err != nil {
+		return "", err
+	}
+	session, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	iamRefreshToken := session.Config.IAMRefreshToken
+
+	actionOptions := &schematicsv1.WorkspaceActivityOptionsTemplate{
+		Target: flex.ExpandStringList(d.Get("targets").([]interface{})),
+		TfVars: flex.ExpandStringList(d.Get("tf_vars").([]interface{})),
+	}
+
+	// A workspace runs one job at a time, the job is retried while another
+	// job holds the workspace
+	activityID := ""
+	err = resource.RetryContext(context, timeout, func() *resource.RetryError {
+		var response *core.DetailedResponse
+		var err error
+		switch action {
+		case schematicsWorkspaceRunPlan:
+			options := &schematicsv1.PlanWorkspaceCommandOptions{}
+			options.SetWID(workspaceID)
+			options.SetRefreshToken(iamRefreshToken)
+			var result *schematicsv1.WorkspaceActivityPlanResult
+			result, response, err = schematicsClient.PlanWorkspaceCommandWithContext(context, options)
+			if err == nil && result.Activityid != nil {
+				activityID = *result.Activityid
+			}
+		case schematicsWorkspaceRunDestroy:
+			options := &schematicsv1.DestroyWorkspaceCommandOptions{}
+			options.SetWID(workspaceID)
+			options.SetRefreshToken(iamRefreshToken)
+			options.SetActionOptions(actionOptions)
+			var result *schematicsv1.WorkspaceActivityDestroyResult
+			result, response, err = schematicsClient.DestroyWorkspaceCommandWithContext(context, options)
+			if err == nil && result.Activityid != nil {
+				activityID = *result.Activityid
+			}
+		default:
+			options := &schematicsv1.ApplyWorkspaceCommandOptions{}
+			options.SetWID(workspaceID)
+			options.SetRefreshToken(iamRefreshToken)
+			options.SetActionOptions(actionOptions)
+			var result *schematicsv1.WorkspaceActivityApplyResult
+			result, response, err = schematicsClient.ApplyWorkspaceCommandWithContext(context, options)
+			if err == nil && result.Activityid != nil {
+				activityID = *result.Activityid
+			}
+		}
+		if err != nil {
+			if response != nil && response.StatusCode == 409 {
+				log.Printf("[DEBUG] Workspace (%s) is busy, retrying the %s job: %s", workspaceID, action, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] Error running the %s job on workspace (%s): %s\n%v", action, workspaceID, err, response))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if activityID == "" {
+		return "", fmt.Errorf("[ERROR] Error running the %s job on workspace (%s): no job ID returned", action, workspaceID)
+	}
+	log.Printf("[INFO] Started the %s job (%s) on workspace (%s)", action, activityID, workspaceID)
+
+	activity, err := waitForSchematicsWorkspaceActivity(context, schematicsClient, workspaceID, activityID, timeout)
+	excerpt := ""
+	if activity != nil {
+		d.Set("status", activity.Status)
+		if len(activity.Templates) > 0 {
+			d.Set("log_url", activity.Templates[0].LogURL)
+			excerpt = getSchematicsActivityLogExcerpt(context, schematicsClient, workspaceID, activity, d.Get("log_excerpt_lines").(int))
+		}
+		d.Set("log_excerpt", excerpt)
+	}
+	if err != nil {
+		return activityID, err
+	}
+	if status := core.StringNilMapper(activity.Status); status != schematicsActivityCompleted {
+		return activityID, fmt.Errorf("[ERROR] The %s job (%s) of workspace (%s) ended with status %s: %s\n%s", action, activityID, workspaceID, status, strings.Join(activity.Message, " "), excerpt)
+	}
+	return activityID, nil
+}
+
+// schematicsWorkspaceRunLogWarning reports the log excerpt of the job that
+// just completed, the log of a failed job is part of its error
+func schematicsWorkspaceRunLogWarning(d *schema.ResourceData) diag.Diagnostics {
+	excerpt := d.Get("log_excerpt").(string)
+	if excerpt == "" {
+		return nil
+	}
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The job (%s) of workspace (%s) completed", d.Get("activity_id").(string), d.Get("workspace_id").(string)),
+			Detail:   excerpt,
+		},
+	}
+}
+
+// waitForSchematicsWorkspaceActivity waits until the job activityID of the
+// workspace is completed, failed or stopped
+func waitForSchematicsWorkspaceActivity(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID, activityID string, timeout time.Duration) (*schematicsv1.WorkspaceActivity, error) {
+	var activity *schematicsv1.WorkspaceActivity
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"done"},
+		Refresh: func() (interface{}, string, error) {
+			getWorkspaceActivityOptions := &schematicsv1.GetWorkspaceActivityOptions{}
+			getWorkspaceActivityOptions.SetWID(workspaceID)
+			getWorkspaceActivityOptions.SetActivityID(activityID)
+			result, response, err := schematicsClient.GetWorkspaceActivityWithContext(context, getWorkspaceActivityOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting job (%s) of workspace (%s): %s\n%s", activityID, workspaceID, err, response)
+			}
+			activity = result
+			switch core.StringNilMapper(result.Status) {
+			case schematicsActivityCompleted, schematicsActivityFailed, schematicsActivityStopped:
+				return result, "done", nil
+			}
+			log.Printf("[DEBUG] Job (%s) of workspace (%s) is %s", activityID, workspaceID, core.StringNilMapper(result.Status))
+			return result, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(context); err != nil {
+		return activity, fmt.Errorf("[ERROR] Error waiting for job (%s) of workspace (%s): %s", activityID, workspaceID, err)
+	}
+	return activity, nil
+}
+
+// getSchematicsActivityLogExcerpt returns the last lines of the log of the
+// job, a log that can't be retrieved is left out
+func getSchematicsActivityLogExcerpt(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID string, activity *schematicsv1.WorkspaceActivity, lines int) string {
+	if lines == 0 || activity.ActionID == nil {
+		return ""
+	}
+	excerpts := []string{}
+	for _, template := range activity.Templates {
+		if template.TemplateID == nil {
+			continue
+		}
+		getTemplateActivityLogOptions := &schematicsv1.GetTemplateActivityLogOptions{}
+		getTemplateActivityLogOptions.SetWID(workspaceID)
+		getTemplateActivityLogOptions.SetTID(*template.TemplateID)
+		getTemplateActivityLogOptions.SetActivityID(*activity.ActionID)
+		logs, response, err := schematicsClient.GetTemplateActivityLogWithContext(context, getTemplateActivityLogOptions)
+		if err != nil || logs == nil {
+			log.Printf("[DEBUG] GetTemplateActivityLogWithContext failed %s\n%s", err, response)
+			continue
+		}
+		logLines := strings.Split(strings.TrimRight(*logs, "\n"), "\n")
+		if len(logLines) > lines {
+			logLines = logLines[len(logLines)-lines:]
+		}
+		excerpts = append(excerpts, strings.Join(logLines, "\n"))
+	}
+	return strings.Join(excerpts, "\n")
+}
+
+// getSchematicsWorkspaceOutputs flattens the outputs of the templates of the
+// workspace, values that aren't strings are encoded in JSON
+func getSchematicsWorkspaceOutputs(context context.Context, schematicsClient *schematicsv1.SchematicsV1, workspaceID string) (map[string]string, error) {
+	getWorkspaceOutputsOptions := &schematicsv1.GetWorkspaceOutputsOptions{}
+	getWorkspaceOutputsOptions.SetWID(workspaceID)
+	outputValues, response, err := schematicsClient.GetWorkspaceOutputsWithContext(context, getWorkspaceOutputsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetWorkspaceOutputsWithContext failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetWorkspaceOutputsWithContext failed %s\n%s", err, response)
+	}
+	outputs := map[string]string{}
+	for _, template := range outputValues {
+		for _, values := range template.OutputValues {
+			valueMap, ok := values.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for name, output := range valueMap {
+				value := output
+				if m, ok := output.(map[string]interface{}); ok {
+					value = m["value"]
+				}
+				if s, ok := value.(string); ok {
+					outputs[name] = s
+					continue
+				}
+				encoded, err := json.Marshal(value)
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error encoding output %s of workspace (%s): %s", name, workspaceID, err)
+				}
+				outputs[name] = string(encoded)
+			}
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSchematicsWorkspaceRunBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceRunConfig(acc.WorkspaceID, "plan", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.schematics_workspace_run", "workspace_id", acc.WorkspaceID),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.schematics_workspace_run", "status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.schematics_workspace_run", "activity_id"),
+					resource.TestMatchResourceAttr("ibm_schematics_workspace_run.schematics_workspace_run", "id", regexp.MustCompile("^"+regexp.QuoteMeta(acc.WorkspaceID)+"/.+$")),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.schematics_workspace_run", "log_url"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceRunConfig(acc.WorkspaceID, "apply", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.schematics_workspace_run", "action", "apply"),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace_run.schematics_workspace_run", "status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet("ibm_schematics_workspace_run.schematics_workspace_run", "log_excerpt"),
+				),
+			},
+			{
+				ResourceName:      "ibm_schematics_workspace_run.schematics_workspace_run",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The ID holds the first job, a plan, the state holds the last job
+				ImportStateVerifyIgnore: []string{"action", "triggers", "activity_id", "status", "log_url", "log_excerpt"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSchematicsWorkspaceRunConfig(workspaceID, action, revision string) string {
+	return fmt.Sprintf(`
+		resource "ibm_schematics_workspace_run" "schematics_workspace_run" {
+			workspace_id = "%s"
+			action       = "%s"
+			triggers = {
+				revision = "%s"
+			}
+		}
+	`, workspaceID, action, revision)
+}
@@ -5,6 +5,8 @@ package schematics_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -109,6 +111,42 @@ func TestAccIBMSchematicsWorkspaceAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMSchematicsWorkspaceTemplateBundle(t *testing.T) {
+	var conf schematicsv1.WorkspaceResponse
+	name := fmt.Sprintf("tf-acc-test-schematics_%d", acctest.RandIntRange(10, 100))
+	bundle := t.TempDir()
+	writeTemplate := func(content string) {
+		if err := ioutil.WriteFile(filepath.Join(bundle, "main.tf"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeTemplate(`output "message" { value = "hello" }`)
+	hash := ""
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMSchematicsWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSchematicsWorkspaceConfigTemplateBundle(name, bundle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsWorkspaceExists("ibm_schematics_workspace.schematics_workspace", conf),
+					resource.TestCheckResourceAttr("ibm_schematics_workspace.schematics_workspace", "template_bundle", bundle),
+					testAccCheckIBMSchematicsWorkspaceTemplateBundleHash("ibm_schematics_workspace.schematics_workspace", &hash, false),
+				),
+			},
+			{
+				PreConfig: func() { writeTemplate(`output "message" { value = "hello again" }`) },
+				Config:    testAccCheckIBMSchematicsWorkspaceConfigTemplateBundle(name, bundle),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMSchematicsWorkspaceTemplateBundleHash("ibm_schematics_workspace.schematics_workspace", &hash, true),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMSchematicsWorkspaceConfigBasic() string {
 	return `
 
@@ -245,3 +283,35 @@ func testAccCheckIBMSchematicsWorkspaceDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMSchematicsWorkspaceConfigTemplateBundle(name string, bundle string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_schematics_workspace" "schematics_workspace" {
+			description = "tf-acc-test-schematics-template-bundle"
+			location = "us-east"
+			name = "%s"
+			resource_group = "default"
+			template_type = "terraform_v0.13.5"
+			template_bundle = "%s"
+		}
+	`, name, bundle)
+}
+
+func testAccCheckIBMSchematicsWorkspaceTemplateBundleHash(n string, hash *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		value := rs.Primary.Attributes["template_bundle_hash"]
+		if value == "" {
+			return fmt.Errorf("template_bundle_hash is not set")
+		}
+		if changed && value == *hash {
+			return fmt.Errorf("template_bundle_hash %s didn't change with the template", value)
+		}
+		*hash = value
+		return nil
+	}
+}
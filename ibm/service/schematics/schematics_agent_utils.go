@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// The agents and policies of the Schematics v2 API aren't covered by the
+// SDK, their requests are built on the service of the SchematicsV1 client
+
+const (
+	schematicsAgentJobFinished  = "job_finished"
+	schematicsAgentJobFailed    = "job_failed"
+	schematicsAgentJobCancelled = "job_cancelled"
+	schematicsAgentJobStopped   = "job_stopped"
+	schematicsAgentJobPending   = "job_pending"
+
+	schematicsPolicyKindAgentAssignment = "agent_assignment_policy"
+)
+
+type schematicsAgentInfrastructure struct {
+	InfraType            *string `json:"infra_type,omitempty"`
+	ClusterID            *string `json:"cluster_id,omitempty"`
+	ClusterResourceGroup *string `json:"cluster_resource_group,omitempty"`
+	CosInstanceName      *string `json:"cos_instance_name,omitempty"`
+	CosBucketName        *string `json:"cos_bucket_name,omitempty"`
+	CosBucketRegion      *string `json:"cos_bucket_region,omitempty"`
+}
+
+type schematicsAgentMetadata struct {
+	Name  *string  `json:"name,omitempty"`
+	Value []string `json:"value,omitempty"`
+}
+
+type schematicsAgentUserState struct {
+	State *string `json:"state,omitempty"`
+	SetBy *string `json:"set_by,omitempty"`
+	SetAt *string `json:"set_at,omitempty"`
+}
+
+// schematicsAgentJob is a deploy or health job of an agent
+type schematicsAgentJob struct {
+	AgentID       *string `json:"agent_id,omitempty"`
+	JobID         *string `json:"job_id,omitempty"`
+	UpdatedAt     *string `json:"updated_at,omitempty"`
+	UpdatedBy     *string `json:"updated_by,omitempty"`
+	IsRedeployed  *bool   `json:"is_redeployed,omitempty"`
+	StatusCode    *string `json:"status_code,omitempty"`
+	StatusMessage *string `json:"status_message,omitempty"`
+	LogURL        *string `json:"log_url,omitempty"`
+}
+
+type schematicsAgent struct {
+	ID                  *string                        `json:"id,omitempty"`
+	Name                *string                        `json:"name,omitempty"`
+	Description         *string                        `json:"description,omitempty"`
+	ResourceGroup       *string                        `json:"resource_group,omitempty"`
+	Tags                []string                       `json:"tags,omitempty"`
+	Version             *string                        `json:"version,omitempty"`
+	SchematicsLocation  *string                        `json:"schematics_location,omitempty"`
+	AgentLocation       *string                        `json:"agent_location,omitempty"`
+	AgentInfrastructure *schematicsAgentInfrastructure `json:"agent_infrastructure,omitempty"`
+	AgentMetadata       []schematicsAgentMetadata      `json:"agent_metadata,omitempty"`
+	UserState           *schematicsAgentUserState      `json:"user_state,omitempty"`
+	CRN                 *string                        `json:"agent_crn,omitempty"`
+	CreatedAt           *string                        `json:"created_at,omitempty"`
+	CreatedBy           *string                        `json:"creation_by,omitempty"`
+	UpdatedAt           *string                        `json:"updated_at,omitempty"`
+	UpdatedBy           *string                        `json:"updated_by,omitempty"`
+	RecentDeployJob     *schematicsAgentJob            `json:"recent_deploy_job,omitempty"`
+	RecentHealthJob     *schematicsAgentJob            `json:"recent_health_job,omitempty"`
+}
+
+type schematicsPolicyScope struct {
+	Kind           *string  `json:"kind,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	ResourceGroups []string `json:"resource_groups,omitempty"`
+	Locations      []string `json:"locations,omitempty"`
+}
+
+type schematicsPolicySelector struct {
+	SelectorKind  *string                 `json:"selector_kind,omitempty"`
+	SelectorIds   []string                `json:"selector_ids,omitempty"`
+	SelectorScope []schematicsPolicyScope `json:"selector_scope,omitempty"`
+}
+
+type schematicsPolicyParameter struct {
+	AgentAssignmentPolicyParameter *schematicsPolicySelector `json:"agent_assignment_policy_parameter,omitempty"`
+}
+
+type schematicsPolicy struct {
+	ID            *string                    `json:"id,omitempty"`
+	Name          *string                    `json:"name,omitempty"`
+	Description   *string                    `json:"description,omitempty"`
+	ResourceGroup *string                    `json:"resource_group,omitempty"`
+	Tags          []string                   `json:"tags,omitempty"`
+	Location      *string                    `json:"location,omitempty"`
+	Kind          *string                    `json:"policy_kind,omitempty"`
+	Target        *schematicsPolicySelector  `json:"policy_target,omitempty"`
+	Parameter     *schematicsPolicyParameter `json:"policy_parameter,omitempty"`
+	CRN           *string                    `json:"crn,omitempty"`
+	Account       *string                    `json:"account,omitempty"`
+	CreatedAt     *string                    `json:"created_at,omitempty"`
+	CreatedBy     *string                    `json:"created_by,omitempty"`
+	UpdatedAt     *string                    `json:"updated_at,omitempty"`
+}
+
+// schematicsRequest sends a request of the Schematics v2 API and decodes the
+// response in result. The IAM refresh token is sent when it is set, the
+// agent jobs run with it.
+func schematicsRequest(context context.Context, schematicsClient *schematicsv1.SchematicsV1, method, path string, pathParams map[string]string, query map[string]string, refreshToken string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(context)
+	builder.EnableGzipCompression = schematicsClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(schematicsClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("X-Feature-Agents", "true")
+	if refreshToken != "" {
+		builder.AddHeader("refresh_token", refreshToken)
+	}
+	for name, value := range query {
+		builder.AddQuery(name, value)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return schematicsClient.Service.Request(request, result)
+}
+
+// waitForSchematicsAgentJob waits until the deploy or health job of the agent
+// ends, kind is the path of the job: deploy or health. The job is returned
+// with an error when it doesn't finish successfully.
+func waitForSchematicsAgentJob(context context.Context, schematicsClient *schematicsv1.SchematicsV1, agentID, kind string, timeout time.Duration) (*schematicsAgentJob, error) {
+	var job *schematicsAgentJob
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{schematicsAgentJobFinished},
+		Refresh: func() (interface{}, string, error) {
+			result := &schematicsAgentJob{}
+			response, err := schematicsRequest(context, schematicsClient, core.GET, "/v2/agents/{agent_id}/"+kind, map[string]string{"agent_id": agentID}, nil, "", nil, result)
+			if err != nil {
+				return nil, "", fmt.Errorf("[ERROR] Error getting %s job of agent (%s): %s\n%s", kind, agentID, err, response)
+			}
+			job = result
+			status := core.StringNilMapper(result.StatusCode)
+			switch status {
+			case schematicsAgentJobFinished:
+				return result, status, nil
+			case schematicsAgentJobFailed, schematicsAgentJobCancelled, schematicsAgentJobStopped:
+				return result, status, fmt.Errorf("[ERROR] The %s job (%s) of agent (%s) ended with status %s: %s, see %s", kind, core.StringNilMapper(result.JobID), agentID, status, core.StringNilMapper(result.StatusMessage), core.StringNilMapper(result.LogURL))
+			}
+			log.Printf("[DEBUG] The %s job of agent (%s) is %s", kind, agentID, status)
+			return result, "pending", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(context)
+	return job, err
+}
@@ -0,0 +1,177 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package schematics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/schematics-go-sdk/schematicsv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// schematicsTemplateBundleSkipDirs are the directories left out of a bundle,
+// they hold local state that Schematics doesn't use
+var schematicsTemplateBundleSkipDirs = map[string]bool{
+	".git":       true,
+	".terraform": true,
+}
+
+// packSchematicsTemplateBundle returns the tar.gz of the template bundle at
+// path and its SHA-256. A tar.gz file is returned as is, a directory is
+// packaged deterministically: the files are sorted by name, and their
+// timestamps, owners and modes are normalized, so the same content always
+// gives the same hash.
+func packSchematicsTemplateBundle(path string) ([]byte, string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error reading template_bundle %s: %s", path, err)
+	}
+
+	var bundle []byte
+	if info.IsDir() {
+		bundle, err = tarSchematicsTemplateDir(path)
+	} else {
+		bundle, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("[ERROR] Error packaging template_bundle %s: %s", path, err)
+	}
+
+	sum := sha256.Sum256(bundle)
+	return bundle, hex.EncodeToString(sum[:]), nil
+}
+
+func tarSchematicsTemplateDir(dir string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	gz, err := gzip.NewWriterLevel(buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	gz.ModTime = time.Unix(0, 0)
+	tw := tar.NewWriter(gz)
+
+	// filepath.Walk visits the files in lexical order
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name, err := filepath.Rel(dir, path)
+		if err != nil || name == "." {
+			return err
+		}
+		if info.IsDir() && schematicsTemplateBundleSkipDirs[info.Name()] {
+			return filepath.SkipDir
+		}
+
+		header := &tar.Header{
+			Name:    filepath.ToSlash(name),
+			ModTime: time.Unix(0, 0),
+			Format:  tar.FormatPAX,
+		}
+		switch {
+		case info.IsDir():
+			header.Typeflag = tar.TypeDir
+			header.Name += "/"
+			header.Mode = 0755
+		case info.Mode().IsRegular():
+			header.Typeflag = tar.TypeReg
+			header.Size = info.Size()
+			header.Mode = 0644
+			if info.Mode()&0111 != 0 {
+				header.Mode = 0755
+			}
+		default:
+			// Symbolic links would point outside of the bundle once uploaded
+			return fmt.Errorf("%s is not a regular file or directory, symbolic links and special files can't be packaged", name)
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if header.Typeflag == tar.TypeReg {
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if _, err := tw.Write(content); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resourceIBMSchematicsWorkspaceTemplateBundleDiff plans the upload of the
+// template bundle when the hash of its content differs from the hash of the
+// last upload
+func resourceIBMSchematicsWorkspaceTemplateBundleDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	path, ok := diff.GetOk("template_bundle")
+	if !ok {
+		return nil
+	}
+	_, hash, err := packSchematicsTemplateBundle(path.(string))
+	if err != nil {
+		return err
+	}
+	if diff.Get("template_bundle_hash").(string) != hash {
+		return diff.SetNew("template_bundle_hash", hash)
+	}
+	return nil
+}
+
+// uploadSchematicsTemplateBundle uploads the template bundle to the first
+// template of the workspace and sets template_bundle_hash
+func uploadSchematicsTemplateBundle(context context.Context, d *schema.ResourceData, schematicsClient *schematicsv1.SchematicsV1, workspaceID string) error {
+	bundle, hash, err := packSchematicsTemplateBundle(d.Get("template_bundle").(string))
+	if err != nil {
+		return err
+	}
+
+	getWorkspaceOptions := &schematicsv1.GetWorkspaceOptions{}
+	getWorkspaceOptions.SetWID(workspaceID)
+	workspaceResponse, response, err := schematicsClient.GetWorkspaceWithContext(context, getWorkspaceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetWorkspaceWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("GetWorkspaceWithContext failed %s\n%s", err, response)
+	}
+	if len(workspaceResponse.TemplateData) == 0 || workspaceResponse.TemplateData[0].ID == nil {
+		return fmt.Errorf("[ERROR] Error uploading template_bundle: workspace (%s) has no template, set template_type", workspaceID)
+	}
+
+	templateRepoUploadOptions := &schematicsv1.TemplateRepoUploadOptions{}
+	templateRepoUploadOptions.SetWID(workspaceID)
+	templateRepoUploadOptions.SetTID(*workspaceResponse.TemplateData[0].ID)
+	templateRepoUploadOptions.SetFile(ioutil.NopCloser(bytes.NewReader(bundle)))
+	templateRepoUploadOptions.SetFileContentType("application/octet-stream")
+	_, response, err = schematicsClient.TemplateRepoUploadWithContext(context, templateRepoUploadOptions)
+	if err != nil {
+		log.Printf("[DEBUG] TemplateRepoUploadWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("TemplateRepoUploadWithContext failed %s\n%s", err, response)
+	}
+	log.Printf("[INFO] Uploaded template_bundle (%s) to workspace (%s)", hash, workspaceID)
+
+	d.Set("template_bundle_hash", hash)
+	return nil
+}
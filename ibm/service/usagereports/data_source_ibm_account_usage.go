@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMAccountUsage() *schema.Resource {
+	accountUsageSchema := usageCostSchema("all the resources of the account")
+	accountUsageSchema["account_id"] = usageAccountIDSchema()
+	accountUsageSchema["month"] = usageMonthSchema()
+	accountUsageSchema["pricing_country"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The target country pricing that is used.",
+	}
+	accountUsageSchema["currency_code"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The currency of the costs.",
+	}
+	accountUsageSchema["resources"] = usageResourcesSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMAccountUsageRead,
+		Schema:      accountUsageSchema,
+	}
+}
+
+func dataSourceIBMAccountUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, err := getUsageAccountID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	month := getUsageMonth(d)
+
+	getAccountUsageOptions := usageReportsClient.NewGetAccountUsageOptions(accountID, month)
+	getAccountUsageOptions.SetNames(true)
+
+	accountUsage, response, err := usageReportsClient.GetAccountUsageWithContext(context, getAccountUsageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetAccountUsageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting usage of account %s for %s: %s\n%s", accountID, month, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, month))
+	d.Set("account_id", accountID)
+	d.Set("month", month)
+	d.Set("pricing_country", accountUsage.PricingCountry)
+	d.Set("currency_code", accountUsage.CurrencyCode)
+	if err = setUsageCosts(d, accountUsage.Resources); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting costs %s", err))
+	}
+	if err = d.Set("resources", flattenUsageResources(accountUsage.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources %s", err))
+	}
+
+	return nil
+}
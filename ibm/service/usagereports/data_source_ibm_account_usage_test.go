@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMAccountUsageDataSource_basic(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMAccountUsageDataSourceConfig(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_account_usage.usage", "month", month),
+					resource.TestCheckResourceAttrSet("data.ibm_account_usage.usage", "account_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_usage.usage", "currency_code"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_usage.usage", "billable_cost"),
+					resource.TestCheckResourceAttrSet("data.ibm_account_usage.usage", "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMAccountUsageDataSource_invalid_month(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMAccountUsageDataSourceConfig("2022-13"),
+				ExpectError: regexp.MustCompile(`must be a month in the format of YYYY-MM`),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAccountUsageDataSourceConfig(month string) string {
+	return fmt.Sprintf(`
+data "ibm_account_usage" "usage" {
+    month = "%s"
+}`, month)
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMResourceGroupUsage() *schema.Resource {
+	resourceGroupUsageSchema := usageCostSchema("all the resources of the resource group")
+	resourceGroupUsageSchema["resource_group_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The ID of the resource group.",
+	}
+	resourceGroupUsageSchema["account_id"] = usageAccountIDSchema()
+	resourceGroupUsageSchema["month"] = usageMonthSchema()
+	resourceGroupUsageSchema["resource_group_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the resource group.",
+	}
+	resourceGroupUsageSchema["pricing_country"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The target country pricing that is used.",
+	}
+	resourceGroupUsageSchema["currency_code"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The currency of the costs.",
+	}
+	resourceGroupUsageSchema["resources"] = usageResourcesSchema()
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourceGroupUsageRead,
+		Schema:      resourceGroupUsageSchema,
+	}
+}
+
+func dataSourceIBMResourceGroupUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, err := getUsageAccountID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resourceGroupID := d.Get("resource_group_id").(string)
+	month := getUsageMonth(d)
+
+	getResourceGroupUsageOptions := usageReportsClient.NewGetResourceGroupUsageOptions(accountID, resourceGroupID, month)
+	getResourceGroupUsageOptions.SetNames(true)
+
+	resourceGroupUsage, response, err := usageReportsClient.GetResourceGroupUsageWithContext(context, getResourceGroupUsageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetResourceGroupUsageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting usage of resource group %s for %s: %s\n%s", resourceGroupID, month, err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", accountID, resourceGroupID, month))
+	d.Set("account_id", accountID)
+	d.Set("month", month)
+	d.Set("resource_group_name", resourceGroupUsage.ResourceGroupName)
+	d.Set("pricing_country", resourceGroupUsage.PricingCountry)
+	d.Set("currency_code", resourceGroupUsage.CurrencyCode)
+	if err = setUsageCosts(d, resourceGroupUsage.Resources); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting costs %s", err))
+	}
+	if err = d.Set("resources", flattenUsageResources(resourceGroupUsage.Resources)); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting resources %s", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceGroupUsageDataSource_basic(t *testing.T) {
+	month := time.Now().UTC().AddDate(0, -1, 0).Format("2006-01")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceGroupUsageDataSourceConfig(month),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_resource_group_usage.usage", "resource_group_id", "data.ibm_resource_group.group", "id"),
+					resource.TestCheckResourceAttr("data.ibm_resource_group_usage.usage", "month", month),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_group_usage.usage", "currency_code"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_group_usage.usage", "billable_cost"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_group_usage.usage", "resources.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceGroupUsageDataSourceConfig(month string) string {
+	return fmt.Sprintf(`
+data "ibm_resource_group" "group" {
+    is_default = true
+}
+
+data "ibm_resource_group_usage" "usage" {
+    resource_group_id = data.ibm_resource_group.group.id
+    month             = "%s"
+}`, month)
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+func DataSourceIBMResourceInstanceUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourceInstanceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the resource instance.",
+			},
+			"account_id": usageAccountIDSchema(),
+			"month":      usageMonthSchema(),
+			"resource_instance_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource instance.",
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the resource.",
+			},
+			"resource_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the resource group of the resource instance.",
+			},
+			"resource_group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the resource group of the resource instance.",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region where the resource instance is provisioned.",
+			},
+			"pricing_country": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The target country pricing that is used.",
+			},
+			"currency_code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The currency of the costs.",
+			},
+			"cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total cost incurred by the resource instance, without the non-chargeable metrics.",
+			},
+			"rated_cost": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The total pre-discounted cost incurred by the resource instance, without the non-chargeable metrics.",
+			},
+			"plans": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The usage of the resource instance for each plan it used during the month.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plan_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the plan.",
+						},
+						"plan_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the plan.",
+						},
+						"pricing_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The pricing region of the plan.",
+						},
+						"billable": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the plan charges are billed to the customer.",
+						},
+						"cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The cost incurred by the plan, without the non-chargeable metrics.",
+						},
+						"rated_cost": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The pre-discounted cost incurred by the plan, without the non-chargeable metrics.",
+						},
+						"usage": usageMetricsSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceInstanceUsageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	usageReportsClient, err := meta.(conns.ClientSession).UsageReportsV4()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID, err := getUsageAccountID(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get("resource_instance_id").(string)
+	month := getUsageMonth(d)
+
+	start := ""
+	var allRecs []usagereportsv4.InstanceUsage
+	for {
+		getResourceUsageAccountOptions := usageReportsClient.NewGetResourceUsageAccountOptions(accountID, month)
+		getResourceUsageAccountOptions.SetResourceInstanceID(instanceID)
+		getResourceUsageAccountOptions.SetNames(true)
+		getResourceUsageAccountOptions.SetLimit(20)
+		if start != "" {
+			getResourceUsageAccountOptions.SetStart(start)
+		}
+
+		instancesUsage, response, err := usageReportsClient.GetResourceUsageAccountWithContext(context, getResourceUsageAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] GetResourceUsageAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting usage of resource instance %s for %s: %s\n%s", instanceID, month, err, response))
+		}
+		allRecs = append(allRecs, instancesUsage.Resources...)
+
+		start = ""
+		if instancesUsage.Next != nil && instancesUsage.Next.Offset != nil {
+			start = *instancesUsage.Next.Offset
+		}
+		if start == "" {
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, month))
+	d.Set("account_id", accountID)
+	d.Set("month", month)
+
+	var cost, ratedCost float64
+	plans := []map[string]interface{}{}
+	for _, instanceUsage := range allRecs {
+		d.Set("resource_instance_name", instanceUsage.ResourceInstanceName)
+		d.Set("resource_id", instanceUsage.ResourceID)
+		d.Set("resource_name", instanceUsage.ResourceName)
+		d.Set("resource_group_id", instanceUsage.ResourceGroupID)
+		d.Set("resource_group_name", instanceUsage.ResourceGroupName)
+		d.Set("region", instanceUsage.Region)
+		d.Set("pricing_country", instanceUsage.PricingCountry)
+		d.Set("currency_code", instanceUsage.CurrencyCode)
+
+		planMap, planCost, planRatedCost := flattenInstanceUsagePlan(instanceUsage)
+		cost += planCost
+		ratedCost += planRatedCost
+		plans = append(plans, planMap)
+	}
+	d.Set("cost", cost)
+	d.Set("rated_cost", ratedCost)
+	if err = d.Set("plans", plans); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting plans %s", err))
+	}
+
+	return nil
+}
+
+// flattenInstanceUsagePlan returns the plan of an instance usage record along
+// with its chargeable costs, the API doesn't sum them up for instances
+func flattenInstanceUsagePlan(instanceUsage usagereportsv4.InstanceUsage) (map[string]interface{}, float64, float64) {
+	var cost, ratedCost float64
+	for _, metric := range instanceUsage.Usage {
+		if metric.NonChargeable != nil && *metric.NonChargeable {
+			continue
+		}
+		cost += floatValue(metric.Cost)
+		ratedCost += floatValue(metric.RatedCost)
+	}
+	planMap := map[string]interface{}{
+		"plan_id":    instanceUsage.PlanID,
+		"billable":   instanceUsage.Billable,
+		"cost":       cost,
+		"rated_cost": ratedCost,
+		"usage":      flattenUsageMetrics(instanceUsage.Usage),
+	}
+	if instanceUsage.PlanName != nil {
+		planMap["plan_name"] = instanceUsage.PlanName
+	}
+	if instanceUsage.PricingRegion != nil {
+		planMap["pricing_region"] = instanceUsage.PricingRegion
+	}
+	return planMap, cost, ratedCost
+}
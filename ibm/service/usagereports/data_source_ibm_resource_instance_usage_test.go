@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceInstanceUsageDataSource_basic(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-usage-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceUsageDataSourceConfig(serviceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_resource_instance_usage.usage", "resource_instance_id", "ibm_resource_instance.instance", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_instance_usage.usage", "month"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_instance_usage.usage", "cost"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_instance_usage.usage", "plans.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceInstanceUsageDataSourceConfig(serviceName string) string {
+	return fmt.Sprintf(`
+resource "ibm_resource_instance" "instance" {
+    name     = "%s"
+    service  = "cloud-object-storage"
+    plan     = "standard"
+    location = "global"
+}
+
+data "ibm_resource_instance_usage" "usage" {
+    resource_instance_id = ibm_resource_instance.instance.id
+}`, serviceName)
+}
@@ -0,0 +1,297 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package usagereports
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+)
+
+// usageMonthSchema is the billing month argument shared by the usage data
+// sources, it defaults to the current month
+func usageMonthSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`), "must be a month in the format of YYYY-MM"),
+		Description:  "The billing month for which the usage is requested, in the format of YYYY-MM. Defaults to the current month.",
+	}
+}
+
+func usageAccountIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The ID of the account. Defaults to the account of the provider credentials.",
+	}
+}
+
+func usageMetricsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The usage and cost of each metric of the plan.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the metric.",
+				},
+				"metric_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the metric.",
+				},
+				"quantity": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The aggregated value of the metric.",
+				},
+				"rateable_quantity": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The quantity that is used for calculating charges.",
+				},
+				"cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The cost incurred by the metric.",
+				},
+				"rated_cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The pre-discounted cost incurred by the metric.",
+				},
+				"unit": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The unit that qualifies the quantity.",
+				},
+				"unit_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the unit.",
+				},
+				"non_chargeable": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the cost is for informational purpose and is not included in the plan charges.",
+				},
+			},
+		},
+	}
+}
+
+// usageCostSchema returns the schema of the total costs of a usage, keyed by
+// attribute name
+func usageCostSchema(entity string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"billable_cost": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The billable charges for " + entity + ".",
+		},
+		"billable_rated_cost": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The billable charges before discounts for " + entity + ".",
+		},
+		"non_billable_cost": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The non-billable charges for " + entity + ".",
+		},
+		"non_billable_rated_cost": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "The non-billable charges before discounts for " + entity + ".",
+		},
+	}
+}
+
+func usageResourcesSchema() *schema.Schema {
+	resourceSchema := usageCostSchema("the resource")
+	resourceSchema["resource_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ID of the resource.",
+	}
+	resourceSchema["resource_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The name of the resource.",
+	}
+	resourceSchema["plans"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The usage of the plans of the resource.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"plan_id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The ID of the plan.",
+				},
+				"plan_name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the plan.",
+				},
+				"pricing_region": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The pricing region of the plan.",
+				},
+				"billable": {
+					Type:        schema.TypeBool,
+					Computed:    true,
+					Description: "Whether the plan charges are billed to the customer.",
+				},
+				"cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The total cost incurred by the plan.",
+				},
+				"rated_cost": {
+					Type:        schema.TypeFloat,
+					Computed:    true,
+					Description: "The total pre-discounted cost incurred by the plan.",
+				},
+				"usage": usageMetricsSchema(),
+			},
+		},
+	}
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The usage of the resources.",
+		Elem: &schema.Resource{
+			Schema: resourceSchema,
+		},
+	}
+}
+
+// getUsageAccountID returns the account_id argument, or the account of the
+// provider credentials when it isn't set
+func getUsageAccountID(d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("account_id"); ok {
+		return v.(string), nil
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	return userDetails.UserAccount, nil
+}
+
+func getUsageMonth(d *schema.ResourceData) string {
+	if v, ok := d.GetOk("month"); ok {
+		return v.(string)
+	}
+	return time.Now().UTC().Format("2006-01")
+}
+
+func flattenUsageMetrics(result []usagereportsv4.Metric) (metrics []map[string]interface{}) {
+	metrics = []map[string]interface{}{}
+	for _, metric := range result {
+		metricMap := map[string]interface{}{
+			"metric":     metric.Metric,
+			"quantity":   metric.Quantity,
+			"cost":       metric.Cost,
+			"rated_cost": metric.RatedCost,
+		}
+		if metric.MetricName != nil {
+			metricMap["metric_name"] = metric.MetricName
+		}
+		if metric.RateableQuantity != nil {
+			metricMap["rateable_quantity"] = metric.RateableQuantity
+		}
+		if metric.Unit != nil {
+			metricMap["unit"] = metric.Unit
+		}
+		if metric.UnitName != nil {
+			metricMap["unit_name"] = metric.UnitName
+		}
+		if metric.NonChargeable != nil {
+			metricMap["non_chargeable"] = metric.NonChargeable
+		}
+		metrics = append(metrics, metricMap)
+	}
+	return metrics
+}
+
+func flattenUsageResources(result []usagereportsv4.Resource) (resources []map[string]interface{}) {
+	resources = []map[string]interface{}{}
+	for _, resource := range result {
+		plans := []map[string]interface{}{}
+		for _, plan := range resource.Plans {
+			planMap := map[string]interface{}{
+				"plan_id":    plan.PlanID,
+				"billable":   plan.Billable,
+				"cost":       plan.Cost,
+				"rated_cost": plan.RatedCost,
+				"usage":      flattenUsageMetrics(plan.Usage),
+			}
+			if plan.PlanName != nil {
+				planMap["plan_name"] = plan.PlanName
+			}
+			if plan.PricingRegion != nil {
+				planMap["pricing_region"] = plan.PricingRegion
+			}
+			plans = append(plans, planMap)
+		}
+		resourceMap := map[string]interface{}{
+			"resource_id":             resource.ResourceID,
+			"billable_cost":           resource.BillableCost,
+			"billable_rated_cost":     resource.BillableRatedCost,
+			"non_billable_cost":       resource.NonBillableCost,
+			"non_billable_rated_cost": resource.NonBillableRatedCost,
+			"plans":                   plans,
+		}
+		if resource.ResourceName != nil {
+			resourceMap["resource_name"] = resource.ResourceName
+		}
+		resources = append(resources, resourceMap)
+	}
+	return resources
+}
+
+// setUsageCosts sets the costs of the resources summed up, so that the total
+// cost can be checked without iterating over the resources
+func setUsageCosts(d *schema.ResourceData, resources []usagereportsv4.Resource) error {
+	costs := map[string]float64{
+		"billable_cost":           0,
+		"billable_rated_cost":     0,
+		"non_billable_cost":       0,
+		"non_billable_rated_cost": 0,
+	}
+	for _, resource := range resources {
+		costs["billable_cost"] += floatValue(resource.BillableCost)
+		costs["billable_rated_cost"] += floatValue(resource.BillableRatedCost)
+		costs["non_billable_cost"] += floatValue(resource.NonBillableCost)
+		costs["non_billable_rated_cost"] += floatValue(resource.NonBillableRatedCost)
+	}
+	for k, v := range costs {
+		if err := d.Set(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func floatValue(v *float64) float64 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}